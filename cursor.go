@@ -0,0 +1,161 @@
+package list
+
+// Cursor is a position in a list. A cursor is either on an element, before the
+// head (index -1), or after the tail (index equal to the list's length).
+// Editing the list through anything other than the cursor invalidates it.
+type Cursor[T any] struct {
+	ls    *List[T]
+	itm   *Element[T]
+	index int
+}
+
+// Cursor returns a cursor on the head of a list. If the list is empty, the
+// cursor is after the tail.
+func (ls *List[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{ls: ls, itm: ls.head}
+}
+
+// Delete the element at the cursor and return its value. The cursor moves to
+// the next element, or after the tail if the deleted element was the tail.
+func (c *Cursor[T]) Delete() T {
+	itm := c.element()
+	c.itm = itm.next
+	return c.ls.RemoveElement(itm)
+}
+
+// element returns the element at the cursor.
+func (c *Cursor[T]) element() *Element[T] {
+	if c.itm == nil {
+		panic("cursor out of range")
+	}
+
+	return c.itm
+}
+
+// Index returns the index of the cursor.
+func (c *Cursor[T]) Index() int {
+	return c.index
+}
+
+// InsertAfter inserts a value after the cursor. The cursor does not move. If
+// the cursor is before the head, the value is prepended.
+func (c *Cursor[T]) InsertAfter(value T) {
+	if c.index < 0 {
+		c.ls.PushFront(value)
+		return
+	}
+
+	c.ls.InsertAfter(value, c.element())
+}
+
+// InsertBefore inserts a value before the cursor. The cursor does not move, so
+// its index is incremented. If the cursor is after the tail, the value is
+// appended.
+func (c *Cursor[T]) InsertBefore(value T) {
+	if c.index == c.ls.length {
+		c.ls.PushBack(value)
+	} else {
+		c.ls.InsertBefore(value, c.element())
+	}
+
+	c.index++
+}
+
+// Next moves the cursor to the next element. Returns true if the cursor is on
+// an element.
+func (c *Cursor[T]) Next() bool {
+	switch {
+	case c.ls.length <= c.index:
+		return false
+	case c.index < 0:
+		c.itm = c.ls.head
+	default:
+		c.itm = c.itm.next
+	}
+
+	c.index++
+	return c.itm != nil
+}
+
+// Peek returns the value of the element after the cursor without moving the
+// cursor. Returns false if there is no such element.
+func (c *Cursor[T]) Peek() (T, bool) {
+	var itm *Element[T]
+	switch {
+	case c.ls.length <= c.index:
+	case c.index < 0:
+		itm = c.ls.head
+	default:
+		itm = c.itm.next
+	}
+
+	if itm == nil {
+		var value T
+		return value, false
+	}
+
+	return itm.value, true
+}
+
+// Prev moves the cursor to the previous element. Returns true if the cursor
+// is on an element.
+func (c *Cursor[T]) Prev() bool {
+	switch {
+	case c.index < 0:
+		return false
+	case c.ls.length <= c.index:
+		c.itm = c.ls.tail
+	default:
+		c.itm = c.itm.prev
+	}
+
+	c.index--
+	return c.itm != nil
+}
+
+// Seek moves the cursor to the ith element, walking from whichever of the
+// cursor, head, or tail is closest.
+func (c *Cursor[T]) Seek(i int) *Cursor[T] {
+	if i < 0 || c.ls.length <= i {
+		panic("index out of range")
+	}
+
+	switch d := i - c.index; {
+	case c.itm == nil, i < abs(d), c.ls.length-1-i < abs(d):
+		c.itm, c.index = c.ls.item(i), i
+	case 0 < d:
+		for ; c.index < i; c.index++ {
+			c.itm = c.itm.next
+		}
+	default:
+		for ; i < c.index; c.index-- {
+			c.itm = c.itm.prev
+		}
+	}
+
+	return c
+}
+
+// Set the value of the element at the cursor.
+func (c *Cursor[T]) Set(value T) {
+	c.element().value = value
+}
+
+// Valid returns true if the cursor is on an element.
+func (c *Cursor[T]) Valid() bool {
+	return c.itm != nil
+}
+
+// Value returns the value of the element at the cursor.
+func (c *Cursor[T]) Value() T {
+	return c.element().value
+}
+
+// abs returns the absolute value of an integer.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
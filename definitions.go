@@ -1,5 +1,10 @@
 package list
 
+import (
+	"cmp"
+	"reflect"
+)
+
 // ----------
 // Interfaces
 // ----------
@@ -9,29 +14,42 @@ type Comparable interface {
 	Compare(Comparable) int
 }
 
+// Equatable defines how a value is compared for equality to another. In a
+// list of interface values, such as a List[interface{}], a value is only
+// Equatable if its Equal method accepts the interface type.
+type Equatable[T any] interface {
+	Equal(T) bool
+}
+
 // ---------
 // Functions
 // ---------
 
+// Equaler determines if two values are equal.
+type Equaler[T any] func(x, y T) bool
+
 // Filterer determines if a value is to be retained.
-type Filterer func(x interface{}) bool
+type Filterer[T any] func(x T) bool
 
 // Generator defines the ith value in a list.
-type Generator func(i int) interface{}
+type Generator[T any] func(i int) T
 
 // Lesser defines the less-than comparison on two values.
-type Lesser func(x, y interface{}) bool
+type Lesser[T any] func(x, y T) bool
 
 // Mapper defines a value from another value.
-type Mapper func(x interface{}) interface{}
+type Mapper[T, U any] func(x T) U
 
-// Reducer defines a value given two values.
-type Reducer func(x, y interface{}) interface{}
+// Reducer defines a value given an accumulated value x and a value y.
+type Reducer[T, U any] func(x U, y T) U
 
 // -------------------------------------
 // Default Less function implementations
 // -------------------------------------
 
+// Less (type Lesser) is the less-than comparison of two ordered values.
+func Less[T cmp.Ordered](x, y T) bool { return cmp.Less(x, y) }
+
 // Bytes (type Lesser) is the less-than comparison of two interface types as bytes.
 func Bytes(x, y interface{}) bool { return x.(byte) < y.(byte) }
 
@@ -79,3 +97,88 @@ func UInt64s(x, y interface{}) bool { return x.(uint64) < y.(uint64) }
 
 // UInts (type Lesser) is the less-than comparison of two interface types as uints.
 func UInts(x, y interface{}) bool { return x.(uint) < y.(uint) }
+
+// -------------------------------------
+// Default Equal function implementation
+// -------------------------------------
+
+// equaler returns the default Equaler for a type, chosen once rather than for
+// each pair of values. Types implementing Equatable are compared by their Equal
+// method, or equal if both are nil, comparable types by ==, and all others by
+// reflect.DeepEqual. Interface types, and types holding them, are compared as in equal, since the
+// comparability of their values is only known at run time.
+func equaler[T any]() Equaler[T] {
+	switch t := reflect.TypeFor[T](); {
+	case t.Implements(reflect.TypeFor[Equatable[T]]()):
+		return equalMethod[T]
+	case comparableType(t):
+		return func(x, y T) bool { return any(x) == any(y) }
+	case t.Comparable():
+		return equal[T]
+	default:
+		return func(x, y T) bool { return reflect.DeepEqual(x, y) }
+	}
+}
+
+// comparableType returns true if all values of a type are comparable by ==.
+// Values of interface types, and of arrays and structs holding them, may not
+// be.
+func comparableType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return comparableType(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !comparableType(t.Field(i).Type) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return t.Comparable()
+	}
+}
+
+// equal (type Equaler) determines if two values are equal, inspecting each
+// pair of values. Values implementing Equatable are compared by their Equal
+// method as in equalMethod, comparable values by ==, and all others by
+// reflect.DeepEqual. For an interface type T, such as interface{}, a value's
+// Equal method is only used if it accepts a T.
+func equal[T any](x, y T) bool {
+	if _, ok := any(x).(Equatable[T]); ok {
+		return equalMethod(x, y)
+	}
+
+	if reflect.ValueOf(x).Comparable() && reflect.ValueOf(y).Comparable() {
+		return any(x) == any(y)
+	}
+
+	return reflect.DeepEqual(x, y)
+}
+
+// equalMethod determines if two Equatable values are equal by the first's Equal
+// method. Nil values are not passed to Equal: two nil values are equal, and a
+// nil value is not equal to any other.
+func equalMethod[T any](x, y T) bool {
+	switch xNil, yNil := isNil(x), isNil(y); {
+	case xNil || yNil:
+		return xNil == yNil
+	default:
+		return any(x).(Equatable[T]).Equal(y)
+	}
+}
+
+// isNil returns true if a value is nil.
+func isNil[T any](x T) bool {
+	switch v := reflect.ValueOf(any(x)); v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
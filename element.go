@@ -0,0 +1,29 @@
+package list
+
+// Element is an item in a list. It holds a value and references it's previous
+// and next elements, if any. An element remains valid until it is removed from
+// its list.
+type Element[T any] struct {
+	value      T
+	prev, next *Element[T]
+}
+
+// Next returns the next element or nil.
+func (e *Element[T]) Next() *Element[T] {
+	return e.next
+}
+
+// Prev returns the previous element or nil.
+func (e *Element[T]) Prev() *Element[T] {
+	return e.prev
+}
+
+// Set the value of an element.
+func (e *Element[T]) Set(value T) {
+	e.value = value
+}
+
+// Value returns the value of an element.
+func (e *Element[T]) Value() T {
+	return e.value
+}
@@ -0,0 +1,83 @@
+package list
+
+import "errors"
+
+var (
+	// ErrCorrupted is returned when a list's references or length are
+	// inconsistent.
+	ErrCorrupted = errors.New("list: corrupted list")
+
+	// ErrEmptyList is returned when an operation requires a non-empty list.
+	ErrEmptyList = errors.New("list: empty list")
+
+	// ErrIndexOutOfRange is returned when an index is not in a list's range.
+	ErrIndexOutOfRange = errors.New("list: index out of range")
+
+	// ErrNoLesser is returned when an operation requires a Less function that
+	// is not set.
+	ErrNoLesser = errors.New("list: less function not set")
+)
+
+// Get returns the ith value from a list. Returns ErrIndexOutOfRange if i is
+// not on the range [0,n).
+func (ls *List[T]) Get(i int) (T, error) {
+	if i < 0 || ls.length <= i {
+		var value T
+		return value, ErrIndexOutOfRange
+	}
+
+	return ls.item(i).value, nil
+}
+
+// TryInsertAt inserts a value into the ith index. Returns ErrIndexOutOfRange
+// if i is not on the range [0,n].
+func (ls *List[T]) TryInsertAt(i int, value T) error {
+	if i < 0 || ls.length < i {
+		return ErrIndexOutOfRange
+	}
+
+	ls.InsertAt(i, value)
+	return nil
+}
+
+// TryReduce reduces a list to a value given a reducing function. Returns
+// ErrEmptyList if the list is empty.
+func (ls *List[T]) TryReduce(f Reducer[T, T]) (T, error) {
+	if ls.length == 0 {
+		var value T
+		return value, ErrEmptyList
+	}
+
+	return ls.Reduce(f), nil
+}
+
+// TryRemoveAt removes the ith value. Returns ErrIndexOutOfRange if i is not on
+// the range [0,n).
+func (ls *List[T]) TryRemoveAt(i int) (T, error) {
+	if i < 0 || ls.length <= i {
+		var value T
+		return value, ErrIndexOutOfRange
+	}
+
+	return ls.RemoveAt(i), nil
+}
+
+// TrySort sorts a list. Returns ErrNoLesser if less is not set.
+func (ls *List[T]) TrySort() error {
+	if ls.less == nil {
+		return ErrNoLesser
+	}
+
+	ls.Sort()
+	return nil
+}
+
+// TrySubList returns a list of the values on the range [i,j). Returns
+// ErrIndexOutOfRange if the range is not within [0,n].
+func (ls *List[T]) TrySubList(i, j int) (*List[T], error) {
+	if j < i || i < 0 || ls.length < j {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return ls.SubList(i, j), nil
+}
@@ -0,0 +1,82 @@
+package list
+
+// FlatMap a list to a new list of another type given a function mapping each
+// value to a list. The values of each mapped list are copied in order. The
+// Less function f is optional, but is required for sorting or calling Less on
+// the new list.
+func FlatMap[T, U any](ls *List[T], m Mapper[T, *List[U]], f Lesser[U]) *List[U] {
+	newLs := New(f)
+	for itm := ls.head; itm != nil; itm = itm.next {
+		for sub := m(itm.value).head; sub != nil; sub = sub.next {
+			newLs.InsertAt(newLs.length, sub.value)
+		}
+	}
+
+	return newLs
+}
+
+// Fold a list to a value given a seed and a reducing function, from head to
+// tail. The seed is returned if the list is empty.
+func Fold[T, U any](ls *List[T], seed U, f Reducer[T, U]) U {
+	for itm := ls.head; itm != nil; itm = itm.next {
+		seed = f(seed, itm.value)
+	}
+
+	return seed
+}
+
+// FoldRight folds a list to a value given a seed and a reducing function, from
+// tail to head. The seed is returned if the list is empty.
+func FoldRight[T, U any](ls *List[T], seed U, f Reducer[T, U]) U {
+	for itm := ls.tail; itm != nil; itm = itm.prev {
+		seed = f(seed, itm.value)
+	}
+
+	return seed
+}
+
+// GroupBy maps each value in a list to a key and returns the lists of values
+// sharing each key. Each list keeps the values in order and the list's Less
+// function.
+func GroupBy[T any, K comparable](ls *List[T], key Mapper[T, K]) map[K]*List[T] {
+	groups := make(map[K]*List[T])
+	for itm := ls.head; itm != nil; itm = itm.next {
+		k := key(itm.value)
+		if _, ok := groups[k]; !ok {
+			groups[k] = New(ls.less)
+		}
+
+		groups[k].InsertAt(groups[k].length, itm.value)
+	}
+
+	return groups
+}
+
+// Scan a list to a new list of the running values of folding it given a seed
+// and a reducing function. The ith value is the fold of the first i+1 values;
+// the seed is not included. The Less function f is optional, but is required
+// for sorting or calling Less on the new list.
+func Scan[T, U any](ls *List[T], seed U, r Reducer[T, U], f Lesser[U]) *List[U] {
+	newLs := New(f)
+	for itm := ls.head; itm != nil; itm = itm.next {
+		seed = r(seed, itm.value)
+		newLs.InsertAt(newLs.length, seed)
+	}
+
+	return newLs
+}
+
+// Partition returns a new list of the values retained by a filter function and
+// a new list of the rest.
+func (ls *List[T]) Partition(f Filterer[T]) (*List[T], *List[T]) {
+	yes, no := New(ls.less), New(ls.less)
+	for itm := ls.head; itm != nil; itm = itm.next {
+		if f(itm.value) {
+			yes.InsertAt(yes.length, itm.value)
+		} else {
+			no.InsertAt(no.length, itm.value)
+		}
+	}
+
+	return yes, no
+}
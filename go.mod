@@ -1,5 +1,5 @@
 module github.com/nathangreene3/list
 
-go 1.13
+go 1.23
 
 require github.com/nathangreene3/math v0.0.0-20200311001644-360c53d733a3
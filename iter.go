@@ -0,0 +1,49 @@
+package list
+
+import "iter"
+
+// Collect a list of values from a sequence. The Less function f is optional,
+// but is required for sorting or calling Less.
+func Collect[T any](seq iter.Seq[T], f Lesser[T]) *List[T] {
+	ls := New(f)
+	for value := range seq {
+		ls.InsertAt(ls.length, value)
+	}
+
+	return ls
+}
+
+// All returns an iterator over the indices and values of a list from head to
+// tail.
+func (ls *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, itm := 0, ls.head; itm != nil; i, itm = i+1, itm.next {
+			if !yield(i, itm.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indices and values of a list from
+// tail to head.
+func (ls *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, itm := ls.length-1, ls.tail; itm != nil; i, itm = i-1, itm.prev {
+			if !yield(i, itm.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of a list from head to tail.
+func (ls *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for itm := ls.head; itm != nil; itm = itm.next {
+			if !yield(itm.value) {
+				return
+			}
+		}
+	}
+}
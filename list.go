@@ -2,26 +2,27 @@ package list
 
 import (
 	"fmt"
-	"reflect"
-	"sort"
 	"strings"
 )
 
-// List is a doubly-linked list. A list implements the sort and heap interface.
-type List struct {
-	head, tail *item
+// List is a doubly-linked list of values of type T. A list implements the sort
+// interface and, for T = interface{}, the heap interface.
+type List[T any] struct {
+	head, tail *Element[T]
 	length     int
-	less       Lesser
+	less       Lesser[T]
 }
 
 // New list of values. The Less function f is optional, but is required for sorting or calling Less.
-func New(f Lesser, values ...interface{}) *List {
-	return (&List{less: f}).Append(values...)
+// Passing one of the default Less functions (Ints, Strings, ...) returns a
+// List[interface{}], matching the behavior of the non-generic list.
+func New[T any](f Lesser[T], values ...T) *List[T] {
+	return (&List[T]{less: f}).Append(values...)
 }
 
 // Generate a list of n values. The Less function f is optional, but is required for sorting or calling Less.
-func Generate(n int, g Generator, f Lesser) *List {
-	ls := List{less: f}
+func Generate[T any](n int, g Generator[T], f Lesser[T]) *List[T] {
+	ls := List[T]{less: f}
 	for ; 0 < n; n-- {
 		ls.InsertAt(ls.length, g(ls.length))
 	}
@@ -29,8 +30,19 @@ func Generate(n int, g Generator, f Lesser) *List {
 	return &ls
 }
 
+// Map a list to a new list of another type given a mapping function. The Less
+// function f is optional, but is required for sorting or calling Less on the new list.
+func Map[T, U any](ls *List[T], m Mapper[T, U], f Lesser[U]) *List[U] {
+	newLs := New(f)
+	for itm := ls.head; itm != nil; itm = itm.next {
+		newLs.InsertAt(newLs.length, m(itm.value))
+	}
+
+	return newLs
+}
+
 // Append several values into a list.
-func (ls *List) Append(values ...interface{}) *List {
+func (ls *List[T]) Append(values ...T) *List[T] {
 	for i := 0; i < len(values); i++ {
 		ls.InsertAt(ls.length, values[i])
 	}
@@ -38,8 +50,13 @@ func (ls *List) Append(values ...interface{}) *List {
 	return ls
 }
 
+// Back returns the last element of a list or nil if the list is empty.
+func (ls *List[T]) Back() *Element[T] {
+	return ls.tail
+}
+
 // Copy a list.
-func (ls *List) Copy() *List {
+func (ls *List[T]) Copy() *List[T] {
 	cpy := New(ls.less)
 	for itm := ls.head; itm != nil; itm = itm.next {
 		cpy.InsertAt(cpy.length, itm.value)
@@ -48,14 +65,27 @@ func (ls *List) Copy() *List {
 	return cpy
 }
 
-// Equal returns true if two lists contain equal values.
-func (ls *List) Equal(list *List) bool {
+// ElementAt returns the ith element of a list.
+func (ls *List[T]) ElementAt(i int) *Element[T] {
+	return ls.item(i)
+}
+
+// Equal returns true if two lists contain equal values. Values implementing
+// Equatable are compared by their Equal method, comparable values by ==, and
+// all others by reflect.DeepEqual.
+func (ls *List[T]) Equal(list *List[T]) bool {
+	return ls.EqualFunc(list, equaler[T]())
+}
+
+// EqualFunc returns true if two lists contain equal values given an equal
+// function.
+func (ls *List[T]) EqualFunc(list *List[T], f Equaler[T]) bool {
 	if ls.length != list.length {
 		return false
 	}
 
 	for left, right := ls.head, list.head; left != nil && right != nil; left, right = left.next, right.next {
-		if left.value != right.value {
+		if !f(left.value, right.value) {
 			return false
 		}
 	}
@@ -64,7 +94,7 @@ func (ls *List) Equal(list *List) bool {
 }
 
 // Filter returns a new list without the filtered values given a filter function.
-func (ls *List) Filter(f Filterer) *List {
+func (ls *List[T]) Filter(f Filterer[T]) *List[T] {
 	newLs := New(ls.less)
 	for itm := ls.head; itm != nil; itm = itm.next {
 		if f(itm.value) {
@@ -75,29 +105,60 @@ func (ls *List) Filter(f Filterer) *List {
 	return newLs
 }
 
+// Front returns the first element of a list or nil if the list is empty.
+func (ls *List[T]) Front() *Element[T] {
+	return ls.head
+}
+
+// InsertAfter inserts a value immediately after an element in O(1) time and
+// returns the new element. The mark must be an element of the list. A nil or
+// removed mark is ignored and nil is returned, as in RemoveElement.
+func (ls *List[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if !ls.owns(mark) {
+		return nil
+	}
+
+	e := &Element[T]{value: value}
+	ls.link(e, mark, mark.next)
+	return e
+}
+
+// InsertBefore inserts a value immediately before an element in O(1) time and
+// returns the new element. The mark must be an element of the list. A nil or
+// removed mark is ignored and nil is returned, as in RemoveElement.
+func (ls *List[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if !ls.owns(mark) {
+		return nil
+	}
+
+	e := &Element[T]{value: value}
+	ls.link(e, mark.prev, mark)
+	return e
+}
+
 // InsertAt inserts a value into the ith index.
-func (ls *List) InsertAt(i int, value interface{}) *List {
+func (ls *List[T]) InsertAt(i int, value T) *List[T] {
 	switch {
 	case i < 0, ls.length < i:
 		panic("index out of range")
 	case i == ls.length:
 		if ls.length == 0 {
 			// i = length = 0 --> initialize head & tail
-			ls.head = &item{value: value}
+			ls.head = &Element[T]{value: value}
 			ls.tail = ls.head
 		} else {
 			// 0 < i = length --> append as new tail
-			ls.tail.next = &item{value: value, prev: ls.tail}
+			ls.tail.next = &Element[T]{value: value, prev: ls.tail}
 			ls.tail = ls.tail.next
 		}
 	case i == 0:
 		// 0 < length --> prepend as new head
-		ls.head.prev = &item{value: value, next: ls.head}
+		ls.head.prev = &Element[T]{value: value, next: ls.head}
 		ls.head = ls.head.prev
 	default:
 		// 0 < i < length --> insert as normal
 		itm := ls.item(i)
-		itm.prev.next = &item{value: value, prev: itm.prev, next: itm}
+		itm.prev.next = &Element[T]{value: value, prev: itm.prev, next: itm}
 		itm.prev = itm.prev.next
 	}
 
@@ -106,12 +167,12 @@ func (ls *List) InsertAt(i int, value interface{}) *List {
 }
 
 // item returns the ith item from a list.
-func (ls *List) item(i int) *item {
+func (ls *List[T]) item(i int) *Element[T] {
 	if i < 0 || ls.length <= i {
 		panic("index out of range")
 	}
 
-	var itm *item
+	var itm *Element[T]
 	if i < ls.length>>1 {
 		// i is closer to 0 than n
 		itm = ls.head
@@ -130,17 +191,36 @@ func (ls *List) item(i int) *item {
 }
 
 // Len of a list.
-func (ls *List) Len() int {
+func (ls *List[T]) Len() int {
 	return ls.length
 }
 
 // Less returns the default less-than comparison on the ith and jth items. Assumes less is set.
-func (ls *List) Less(i, j int) bool {
+func (ls *List[T]) Less(i, j int) bool {
 	return ls.less(ls.item(i).value, ls.item(j).value)
 }
 
+// link an element between two adjacent elements. A nil prev or next links the
+// element as the new head or tail, respectively.
+func (ls *List[T]) link(e, prev, next *Element[T]) {
+	e.prev, e.next = prev, next
+	if prev == nil {
+		ls.head = e
+	} else {
+		prev.next = e
+	}
+
+	if next == nil {
+		ls.tail = e
+	} else {
+		next.prev = e
+	}
+
+	ls.length++
+}
+
 // Map a list to a new list given a mapping function.
-func (ls *List) Map(f Mapper) *List {
+func (ls *List[T]) Map(f Mapper[T, T]) *List[T] {
 	newLs := New(ls.less)
 	for itm := ls.head; itm != nil; itm = itm.next {
 		newLs.InsertAt(newLs.length, f(itm.value))
@@ -149,13 +229,78 @@ func (ls *List) Map(f Mapper) *List {
 	return newLs
 }
 
+// MoveAfter moves an element immediately after another element in O(1) time.
+// Both elements must be elements of the list. Nil or removed elements are
+// ignored, as in RemoveElement.
+func (ls *List[T]) MoveAfter(e, mark *Element[T]) {
+	if !ls.owns(e) || !ls.owns(mark) || e == mark || mark.next == e {
+		return
+	}
+
+	ls.unlink(e)
+	ls.link(e, mark, mark.next)
+}
+
+// MoveBefore moves an element immediately before another element in O(1)
+// time. Both elements must be elements of the list. Nil or removed elements
+// are ignored, as in RemoveElement.
+func (ls *List[T]) MoveBefore(e, mark *Element[T]) {
+	if !ls.owns(e) || !ls.owns(mark) || e == mark || e.next == mark {
+		return
+	}
+
+	ls.unlink(e)
+	ls.link(e, mark.prev, mark)
+}
+
+// MoveToBack moves an element to the back of a list in O(1) time. The element
+// must be an element of the list. A nil or removed element is ignored, as in
+// RemoveElement.
+func (ls *List[T]) MoveToBack(e *Element[T]) {
+	if e == ls.tail || !ls.owns(e) {
+		return
+	}
+
+	ls.unlink(e)
+	ls.link(e, ls.tail, nil)
+}
+
+// MoveToFront moves an element to the front of a list in O(1) time. The
+// element must be an element of the list. A nil or removed element is ignored,
+// as in RemoveElement.
+func (ls *List[T]) MoveToFront(e *Element[T]) {
+	if e == ls.head || !ls.owns(e) {
+		return
+	}
+
+	ls.unlink(e)
+	ls.link(e, nil, ls.head)
+}
+
+// owns returns true if an element is linked into a list. Items are relinked
+// between lists in O(1) time, so elements do not reference their list. A
+// removed element, or the head or tail of another list, is detected in O(1)
+// time, but an element in the interior of another list is not.
+func (ls *List[T]) owns(e *Element[T]) bool {
+	switch {
+	case e == nil:
+		return false
+	case e.prev == nil && ls.head != e, e.prev != nil && e.prev.next != e:
+		return false
+	case e.next == nil && ls.tail != e, e.next != nil && e.next.prev != e:
+		return false
+	default:
+		return true
+	}
+}
+
 // Pop removes the tail value from a list.
-func (ls *List) Pop() interface{} {
+func (ls *List[T]) Pop() T {
 	return ls.RemoveAt(ls.length - 1)
 }
 
 // Prepend inserts values at the beginning of a list.
-func (ls *List) Prepend(values ...interface{}) *List {
+func (ls *List[T]) Prepend(values ...T) *List[T] {
 	for i := 0; i < len(values); i++ {
 		ls.InsertAt(0, values[i])
 	}
@@ -164,12 +309,26 @@ func (ls *List) Prepend(values ...interface{}) *List {
 }
 
 // Push appends a value onto a list.
-func (ls *List) Push(value interface{}) {
+func (ls *List[T]) Push(value T) {
 	ls.InsertAt(ls.length, value)
 }
 
+// PushBack appends a value onto a list and returns its element.
+func (ls *List[T]) PushBack(value T) *Element[T] {
+	e := &Element[T]{value: value}
+	ls.link(e, ls.tail, nil)
+	return e
+}
+
+// PushFront prepends a value onto a list and returns its element.
+func (ls *List[T]) PushFront(value T) *Element[T] {
+	e := &Element[T]{value: value}
+	ls.link(e, nil, ls.head)
+	return e
+}
+
 // Reduce a list to a value given a reducing function.
-func (ls *List) Reduce(f Reducer) interface{} {
+func (ls *List[T]) Reduce(f Reducer[T, T]) T {
 	if ls.length == 0 {
 		panic("list: cannot reduce empty list")
 	}
@@ -182,35 +341,14 @@ func (ls *List) Reduce(f Reducer) interface{} {
 	return value
 }
 
-// Remove values from the list.
-func (ls *List) Remove(values ...interface{}) *List {
-	for i := 0; i < len(values); i++ {
-		t := reflect.TypeOf(values[i])
-		for itm := ls.head; itm != nil; itm = itm.next {
-			if reflect.TypeOf(itm.value) == t && values[i] == itm.value {
-				switch itm {
-				case ls.head:
-					itm.next.prev = nil
-					ls.head = itm.next
-				case ls.tail:
-					itm.prev.next = nil
-					ls.tail = itm.prev
-				default:
-					itm.prev.next = itm.next
-					itm.next.prev = itm.prev
-				}
-
-				ls.length--
-			}
-		}
-	}
-
-	return ls
+// Remove values from the list. Values are compared as in Equal.
+func (ls *List[T]) Remove(values ...T) *List[T] {
+	return ls.RemoveFunc(equaler[T](), values...)
 }
 
 // RemoveAt the ith value.
-func (ls *List) RemoveAt(i int) interface{} {
-	var value interface{}
+func (ls *List[T]) RemoveAt(i int) T {
+	var value T
 	switch {
 	case i < 0, ls.length <= i:
 		panic("index out of range")
@@ -246,16 +384,54 @@ func (ls *List) RemoveAt(i int) interface{} {
 	return value
 }
 
+// RemoveFunc removes values from the list given an equal function.
+func (ls *List[T]) RemoveFunc(f Equaler[T], values ...T) *List[T] {
+	for i := 0; i < len(values); i++ {
+		for itm := ls.head; itm != nil; {
+			next := itm.next
+			if f(values[i], itm.value) {
+				ls.unlink(itm)
+			}
+
+			itm = next
+		}
+	}
+
+	return ls
+}
+
+// RemoveElement removes an element from a list in O(1) time and returns its
+// value. The element must be an element of the list. A removed element, or the
+// head or tail of another list, is ignored and its value returned, and a nil
+// element returns the zero value. Elements do not reference their list, so
+// passing an element from the interior of another list corrupts both lists.
+func (ls *List[T]) RemoveElement(e *Element[T]) T {
+	if !ls.owns(e) {
+		var value T
+		if e != nil {
+			value = e.value
+		}
+
+		return value
+	}
+
+	ls.unlink(e)
+	return e.value
+}
+
 // Search returns the index a value was found at or the length of the list and
-// whether or not the value was found in the list.
-func (ls *List) Search(value interface{}) (int, bool) {
-	var (
-		i int
-		t = reflect.TypeOf(value)
-	)
+// whether or not the value was found in the list. Values are compared as in
+// Equal.
+func (ls *List[T]) Search(value T) (int, bool) {
+	return ls.SearchFunc(value, equaler[T]())
+}
 
+// SearchFunc returns the index a value was found at or the length of the list
+// and whether or not the value was found in the list given an equal function.
+func (ls *List[T]) SearchFunc(value T, f Equaler[T]) (int, bool) {
+	var i int
 	for itm := ls.head; itm != nil; itm = itm.next {
-		if reflect.TypeOf(itm.value) == t && value == itm.value {
+		if f(value, itm.value) {
 			return i, true
 		}
 
@@ -266,14 +442,14 @@ func (ls *List) Search(value interface{}) (int, bool) {
 }
 
 // SetLess sets the less function for a list.
-func (ls *List) SetLess(less Lesser) *List {
+func (ls *List[T]) SetLess(less Lesser[T]) *List[T] {
 	ls.less = less
 	return ls
 }
 
 // Slice a list of values.
-func (ls *List) Slice() []interface{} {
-	s := make([]interface{}, 0, ls.length)
+func (ls *List[T]) Slice() []T {
+	s := make([]T, 0, ls.length)
 	for itm := ls.head; itm != nil; itm = itm.next {
 		s = append(s, itm.value)
 	}
@@ -281,14 +457,8 @@ func (ls *List) Slice() []interface{} {
 	return s
 }
 
-// Sort a list. Assumes less is set.
-func (ls *List) Sort() *List {
-	sort.Sort(ls)
-	return ls
-}
-
 // String represents a formatted list.
-func (ls *List) String() string {
+func (ls *List[T]) String() string {
 	s := make([]string, 0, ls.length<<1)
 	for itm := ls.head; itm != nil; itm = itm.next {
 		s = append(s, fmt.Sprintf("%v", itm.value))
@@ -298,12 +468,16 @@ func (ls *List) String() string {
 }
 
 // SubList returns a list of the values on the range [i,j) having length j-i.
-func (ls *List) SubList(i, j int) *List {
+func (ls *List[T]) SubList(i, j int) *List[T] {
 	if j < i || i < 0 || ls.length < j {
 		panic("index out of range")
 	}
 
 	sub := New(ls.less)
+	if i == j {
+		return sub
+	}
+
 	for itm := ls.item(i); i < j && itm != nil; itm = itm.next {
 		sub.InsertAt(sub.length, itm.value)
 		i++
@@ -313,15 +487,15 @@ func (ls *List) SubList(i, j int) *List {
 }
 
 // Swap two items in a list.
-func (ls *List) Swap(i, j int) {
+func (ls *List[T]) Swap(i, j int) {
 	x, y := ls.item(i), ls.item(j)
 	x.value, y.value = y.value, x.value
 }
 
 // ToMap returns a map indices to their values.
-func (ls *List) ToMap() map[int]interface{} {
+func (ls *List[T]) ToMap() map[int]T {
 	var (
-		m = make(map[int]interface{})
+		m = make(map[int]T)
 		i int
 	)
 
@@ -333,7 +507,25 @@ func (ls *List) ToMap() map[int]interface{} {
 	return m
 }
 
+// unlink an element from a list. The element's references are cleared.
+func (ls *List[T]) unlink(e *Element[T]) {
+	if e.prev == nil {
+		ls.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+
+	if e.next == nil {
+		ls.tail = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+
+	e.prev, e.next = nil, nil
+	ls.length--
+}
+
 // Value returns the ith value from a list. Value is not removed from the list.
-func (ls *List) Value(i int) interface{} {
+func (ls *List[T]) Value(i int) T {
 	return ls.item(i).value
 }
@@ -3,8 +3,10 @@ package list
 import (
 	"container/heap"
 	golist "container/list"
+	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -62,6 +64,74 @@ func TestList(t *testing.T) {
 	}
 }
 
+// TestSort ensures sorting a list relinks its items in the same order as sorting a slice.
+func TestSort(t *testing.T) {
+	for n := 0; n <= 1<<12; n = n<<1 + 1 {
+		var (
+			ls   = New(Less[int])
+			nums = make([]int, 0, n)
+		)
+
+		for i := 0; i < n; i++ {
+			x := rand.Intn(n)
+			ls.Append(x)
+			nums = append(nums, x)
+		}
+
+		sort.Ints(nums)
+		ls.Sort()
+		if len(nums) != ls.Len() {
+			t.Fatalf("\nexpected length %d\nreceived %d\n", len(nums), ls.Len())
+		}
+
+		var prev *Element[int]
+		for i, itm := 0, ls.head; itm != nil; i, itm = i+1, itm.next {
+			if nums[i] != itm.value || prev != itm.prev {
+				t.Fatalf("\nexpected %v\nreceived %v\n", nums, ls)
+			}
+
+			prev = itm
+		}
+
+		if prev != ls.tail {
+			t.Fatalf("\nexpected tail %v\nreceived %v\n", prev, ls.tail)
+		}
+	}
+}
+
+// TestSortStable sorts records by several keys, relying on each sort to keep the previous order among equal keys.
+func TestSortStable(t *testing.T) {
+	type record struct {
+		name string
+		age  int
+	}
+
+	var (
+		byName Lesser[record] = func(x, y record) bool { return x.name < y.name }
+		byAge  Lesser[record] = func(x, y record) bool { return x.age < y.age }
+		ls                    = New(byName, record{"c", 2}, record{"a", 1}, record{"b", 2}, record{"d", 1})
+		exp                   = []record{{"a", 1}, {"d", 1}, {"b", 2}, {"c", 2}}
+	)
+
+	rec := ls.SortStable().SortStableFunc(byAge).Slice()
+	for i := 0; i < len(exp); i++ {
+		if exp[i] != rec[i] {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+		}
+	}
+
+	if ls.Less(1, 2) {
+		t.Fatalf("\nexpected list to keep its less function\n")
+	}
+
+	rec = ls.SortFunc(byName).Slice()
+	for i := 1; i < len(rec); i++ {
+		if rec[i].name < rec[i-1].name {
+			t.Fatalf("\nexpected %v to be sorted by name\n", rec)
+		}
+	}
+}
+
 func TestComparable(t *testing.T) {
 	ls := New(CmpLess)
 	for i := 0; i < 8; i++ {
@@ -74,6 +144,512 @@ func TestComparable(t *testing.T) {
 	}
 }
 
+// TestFunctional folds, scans, flattens, partitions, and groups a list of integers.
+func TestFunctional(t *testing.T) {
+	var (
+		ls                         = New(Less[int], 1, 2, 3, 4)
+		digit Reducer[int, string] = func(x string, y int) string { return x + fmt.Sprint(y) }
+	)
+
+	if exp, rec := ">1234", Fold(ls, ">", digit); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	if exp, rec := ">4321", FoldRight(ls, ">", digit); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	if exp, rec := 0, Fold(New(Less[int]), 0, func(x, y int) int { return x + y }); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	sums := Scan(ls, 0, func(x, y int) int { return x + y }, Less[int])
+	checkElements(t, sums, 1, 3, 6, 10)
+
+	flat := FlatMap(ls, func(x int) *List[int] { return Generate(x, func(int) int { return x }, nil) }, Less[int])
+	checkElements(t, flat, 1, 2, 2, 3, 3, 3, 4, 4, 4, 4)
+
+	even, odd := ls.Partition(func(x int) bool { return x%2 == 0 })
+	checkElements(t, even, 2, 4)
+	checkElements(t, odd, 1, 3)
+
+	groups := GroupBy(flat, func(x int) bool { return x%2 == 0 })
+	checkElements(t, groups[true], 2, 2, 4, 4, 4, 4)
+	checkElements(t, groups[false], 1, 3, 3, 3)
+	if len(groups) != 2 {
+		t.Fatalf("\nexpected %d groups\nreceived %d\n", 2, len(groups))
+	}
+}
+
+// TestGeneric ensures a typed list behaves as its untyped counterpart.
+func TestGeneric(t *testing.T) {
+	ls := New(Less[int], 3, 1, 2).Sort()
+	exp := []int{1, 2, 3}
+	if rec := ls.Slice(); len(exp) != len(rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	} else {
+		for i := 0; i < len(exp); i++ {
+			if exp[i] != rec[i] {
+				t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+			}
+		}
+	}
+
+	var (
+		mpr Mapper[int, string]     = func(x int) string { return strings.Repeat("a", x) }
+		red Reducer[string, string] = func(x, y string) string { return x + y }
+	)
+
+	if exp, rec := "aaaaaa", Map(ls, mpr, Less[string]).Reduce(red); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+}
+
+// TestElement manipulates a list through element handles.
+func TestElement(t *testing.T) {
+	var (
+		ls = New(Less[int])
+		e2 = ls.PushBack(2)
+		e0 = ls.PushFront(0)
+		e4 = ls.PushBack(4)
+		e1 = ls.InsertAfter(1, e0)
+		e3 = ls.InsertBefore(3, e4)
+	)
+
+	checkElements(t, ls, 0, 1, 2, 3, 4)
+	if ls.Front() != e0 || ls.Back() != e4 || ls.ElementAt(2) != e2 || e1.Next() != e2 || e3.Prev() != e2 {
+		t.Fatalf("\nunexpected elements in %v\n", ls)
+	}
+
+	ls.MoveToFront(e4)
+	checkElements(t, ls, 4, 0, 1, 2, 3)
+	ls.MoveToBack(e0)
+	checkElements(t, ls, 4, 1, 2, 3, 0)
+	ls.MoveBefore(e0, e1)
+	checkElements(t, ls, 4, 0, 1, 2, 3)
+	ls.MoveAfter(e4, e3)
+	checkElements(t, ls, 0, 1, 2, 3, 4)
+
+	e2.Set(5)
+	if v := ls.RemoveElement(e2); v != 5 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 5, v)
+	}
+
+	checkElements(t, ls, 0, 1, 3, 4)
+	ls.RemoveElement(e0)
+	ls.RemoveElement(e4)
+	checkElements(t, ls, 1, 3)
+	ls.RemoveElement(e1)
+	ls.RemoveElement(e3)
+	checkElements(t, ls)
+}
+
+func TestForeignElement(t *testing.T) {
+	var (
+		a = New(Less[int], 0, 1, 2)
+		b = New(Less[int], 3, 4, 5)
+		e = a.ElementAt(1)
+	)
+
+	if v := a.RemoveElement(e); v != 1 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 1, v)
+	}
+
+	a.RemoveElement(e)
+	checkElements(t, a, 0, 2)
+	a.MoveToFront(e)
+	a.MoveAfter(e, a.Front())
+	if a.InsertBefore(6, e) != nil {
+		t.Fatalf("\nexpected nil\nreceived %v\n", a)
+	}
+
+	checkElements(t, a, 0, 2)
+	a.MoveToFront(b.Front())
+	a.MoveToBack(b.Back())
+	a.MoveBefore(b.Back(), a.Back())
+	a.MoveAfter(a.Front(), b.Front())
+	a.RemoveElement(b.Front())
+	if v := a.RemoveElement(nil); v != 0 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 0, v)
+	}
+
+	checkElements(t, a, 0, 2)
+	checkElements(t, b, 3, 4, 5)
+}
+
+// checkElements ensures a list is valid and holds the expected values when walked from either end.
+func checkElements(t *testing.T, ls *List[int], exp ...int) {
+	t.Helper()
+	if err := ls.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(exp) != ls.Len() {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, ls)
+	}
+
+	e := ls.Front()
+	for i := 0; i < len(exp); i, e = i+1, e.Next() {
+		if e == nil || exp[i] != e.Value() {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp, ls)
+		}
+	}
+
+	e = ls.Back()
+	for i := len(exp) - 1; 0 <= i; i, e = i-1, e.Prev() {
+		if e == nil || exp[i] != e.Value() {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp, ls)
+		}
+	}
+
+	if e != nil {
+		t.Fatalf("\nexpected nil\nreceived %v\n", e.Value())
+	}
+}
+
+// TestCursor edits a list as a text buffer through a cursor.
+func TestCursor(t *testing.T) {
+	var (
+		ls = New(Less[rune])
+		c  = ls.Cursor()
+	)
+
+	for _, r := range "hllo" {
+		c.InsertBefore(r)
+	}
+
+	if exp, rec := "hllo", string(ls.Slice()); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	c.Seek(1).InsertBefore('e')
+	if exp, rec := 'l', c.Value(); exp != rec || c.Index() != 2 {
+		t.Fatalf("\nexpected %q at 2\nreceived %q at %d\n", exp, rec, c.Index())
+	}
+
+	if r, ok := c.Peek(); r != 'l' || !ok {
+		t.Fatalf("\nexpected ('l', true)\nreceived (%q, %t)\n", r, ok)
+	}
+
+	c.Seek(4).Set('!')
+	c.InsertAfter('?')
+	if c.Next(); c.Delete() != '?' || c.Valid() || c.Next() {
+		t.Fatalf("\nexpected cursor after tail\nreceived %d in %v\n", c.Index(), ls)
+	}
+
+	for c.Prev() {
+	}
+
+	c.InsertAfter('>')
+	if exp, rec := ">hell!", string(ls.Slice()); exp != rec || c.Index() != -1 {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	for c.Next() {
+		c.Set(c.Value() + 1)
+	}
+
+	if exp, rec := "?ifmm\"", string(ls.Slice()); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+}
+
+// TestBinarySearch compares searching a sorted list to searching a sorted slice.
+func TestBinarySearch(t *testing.T) {
+	var (
+		ls   = New(Less[int])
+		nums []int
+	)
+
+	for i := 0; i < 256; i++ {
+		x := rand.Intn(64)
+		ls.InsertSorted(x)
+		j, _ := slices.BinarySearch(nums, x+1)
+		nums = slices.Insert(nums, j, x)
+	}
+
+	checkElements(t, ls, nums...)
+	for x := -1; x <= 64; x++ {
+		i, ok := slices.BinarySearch(nums, x)
+		if index, found := ls.BinarySearch(x); i != index || ok != found {
+			t.Fatalf("\nexpected (%d, %t)\nreceived (%d, %t)\n", i, ok, index, found)
+		}
+
+		if index := ls.LowerBound(x); i != index {
+			t.Fatalf("\nexpected %d\nreceived %d\n", i, index)
+		}
+
+		j, _ := slices.BinarySearch(nums, x+1)
+		if index := ls.UpperBound(x); j != index {
+			t.Fatalf("\nexpected %d\nreceived %d\n", j, index)
+		}
+	}
+
+	if i, ok := New(Less[int]).BinarySearch(1); i != 0 || ok {
+		t.Fatalf("\nexpected (%d, %t)\nreceived (%d, %t)\n", 0, false, i, ok)
+	}
+}
+
+// TestFind searches a list by value and by predicate from either end.
+func TestFind(t *testing.T) {
+	var (
+		ls   = New(Ints, 0, 1, 2, 1, 0, "1")
+		ones = func(x interface{}) bool { return x == 1 }
+	)
+
+	if i := ls.IndexFunc(ones); i != 1 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 1, i)
+	}
+
+	if i := ls.LastIndexFunc(ones); i != 3 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 3, i)
+	}
+
+	if i, j := ls.LastIndex(0), ls.LastIndex(3); i != 4 || j != -1 {
+		t.Fatalf("\nexpected (%d, %d)\nreceived (%d, %d)\n", 4, -1, i, j)
+	}
+
+	if i := ls.IndexFunc(func(x interface{}) bool { return x == 3 }); i != -1 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", -1, i)
+	}
+
+	if exp, rec := []int{1, 3}, ls.FindAll(ones); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	if n := ls.CountFunc(ones); n != 2 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 2, n)
+	}
+
+	if !ls.Contains("1") || ls.Contains(3) || ls.Contains("0") {
+		t.Fatalf("\nunexpected Contains in %v\n", ls)
+	}
+}
+
+// testPoint is equatable by its coordinates.
+type testPoint struct {
+	x, y int
+}
+
+// Equal returns true if two points have equal coordinates.
+func (p *testPoint) Equal(q *testPoint) bool {
+	return p.x == q.x && p.y == q.y
+}
+
+// TestEqual compares, searches, and removes values that are not comparable with ==.
+func TestEqual(t *testing.T) {
+	type record struct {
+		name string
+		tags []string
+	}
+
+	var (
+		a = New(nil, record{"a", []string{"x"}}, record{"b", nil})
+		b = New(nil, record{"a", []string{"x"}}, record{"b", nil})
+	)
+
+	if !a.Equal(b) {
+		t.Fatalf("\nexpected %v to equal %v\n", a, b)
+	}
+
+	if i, ok := a.Search(record{"b", nil}); i != 1 || !ok {
+		t.Fatalf("\nexpected (%d, %t)\nreceived (%d, %t)\n", 1, true, i, ok)
+	}
+
+	byName := func(x, y record) bool { return x.name == y.name }
+	if i, ok := a.SearchFunc(record{name: "b"}, byName); i != 1 || !ok {
+		t.Fatalf("\nexpected (%d, %t)\nreceived (%d, %t)\n", 1, true, i, ok)
+	}
+
+	if b.Remove(record{"a", []string{"y"}}); b.Len() != 2 || a.EqualFunc(b.RemoveFunc(byName, record{name: "a"}), byName) {
+		t.Fatalf("\nunexpected Remove in %v\n", b)
+	}
+
+	ps := New(nil, &testPoint{1, 2}, &testPoint{3, 4})
+	if !ps.Equal(New(nil, &testPoint{1, 2}, &testPoint{3, 4})) || !ps.Contains(&testPoint{3, 4}) {
+		t.Fatalf("\nexpected equatable points\n")
+	}
+
+	if ps.Remove(&testPoint{1, 2}, &testPoint{3, 4}); ps.Len() != 0 || ps.Front() != nil || ps.Back() != nil {
+		t.Fatalf("\nexpected empty list\nreceived %v\n", ps)
+	}
+
+	ps = New[*testPoint](nil, nil, &testPoint{1, 2})
+	if i, ok := ps.Search(&testPoint{1, 2}); i != 1 || !ok {
+		t.Fatalf("\nexpected (%d, %t)\nreceived (%d, %t)\n", 1, true, i, ok)
+	}
+
+	if !ps.Contains(nil) || ps.Equal(New[*testPoint](nil, &testPoint{1, 2}, nil)) {
+		t.Fatalf("\nunexpected comparison of nil points in %v\n", ps)
+	}
+}
+
+// TestEqualAllocs ensures comparing values does not allocate for each value.
+func TestEqualAllocs(t *testing.T) {
+	ls := New(Less[int])
+	for i := 0; i < 1000; i++ {
+		ls.Append(1000 + i)
+	}
+
+	if n := testing.AllocsPerRun(10, func() { ls.Contains(-1) }); 1 < n {
+		t.Fatalf("\nexpected at most 1 allocation\nreceived %.0f\n", n)
+	}
+
+	if n := testing.AllocsPerRun(10, func() { ls.Equal(ls) }); 1 < n {
+		t.Fatalf("\nexpected at most 1 allocation\nreceived %.0f\n", n)
+	}
+}
+
+// TestErrors ensures bad indices and empty lists return errors rather than panicking.
+func TestErrors(t *testing.T) {
+	ls := New(nil, 1, 2, 3)
+	if _, err := ls.Get(3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrIndexOutOfRange, err)
+	}
+
+	if v, err := ls.Get(2); v != 3 || err != nil {
+		t.Fatalf("\nexpected (%d, %v)\nreceived (%d, %v)\n", 3, nil, v, err)
+	}
+
+	if err := ls.TryInsertAt(-1, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrIndexOutOfRange, err)
+	}
+
+	if err := ls.TryInsertAt(3, 4); err != nil || ls.Len() != 4 {
+		t.Fatalf("\nexpected %v\nreceived %v\n", nil, err)
+	}
+
+	if _, err := ls.TryRemoveAt(4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrIndexOutOfRange, err)
+	}
+
+	if v, err := ls.TryRemoveAt(0); v != 1 || err != nil {
+		t.Fatalf("\nexpected (%d, %v)\nreceived (%d, %v)\n", 1, nil, v, err)
+	}
+
+	if _, err := ls.TrySubList(2, 1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrIndexOutOfRange, err)
+	}
+
+	if err := ls.TrySort(); !errors.Is(err, ErrNoLesser) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrNoLesser, err)
+	}
+
+	if _, err := New[int](nil).TryReduce(func(x, y int) int { return x + y }); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrEmptyList, err)
+	}
+}
+
+// TestValidate corrupts a list's references and length.
+func TestValidate(t *testing.T) {
+	ls := New(nil, 0)
+	if err := ls.Remove(0).Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(ls *List[int])
+	}{
+		{name: "head", corrupt: func(ls *List[int]) { ls.head = nil }},
+		{name: "head prev", corrupt: func(ls *List[int]) { ls.head.prev = ls.tail }},
+		{name: "tail next", corrupt: func(ls *List[int]) { ls.tail.next = ls.head }},
+		{name: "asymmetric prev", corrupt: func(ls *List[int]) { ls.tail.prev = ls.head }},
+		{name: "cycle", corrupt: func(ls *List[int]) { ls.head.next.next = ls.head.next }},
+		{name: "length", corrupt: func(ls *List[int]) { ls.length++ }},
+		{name: "tail", corrupt: func(ls *List[int]) { ls.tail = ls.tail.prev }},
+	}
+
+	for _, test := range tests {
+		ls := New(nil, 0, 1, 2, 3)
+		test.corrupt(ls)
+		if err := ls.Validate(); !errors.Is(err, ErrCorrupted) {
+			t.Fatalf("\n%s\nexpected %v\nreceived %v\n", test.name, ErrCorrupted, err)
+		}
+	}
+}
+
+// TestSplice moves items between lists without copying them.
+func TestSplice(t *testing.T) {
+	var (
+		a, b = New(Less[int], 0, 1), New(Less[int], 4, 5)
+		e4   = b.Front()
+	)
+
+	a.Concat(b).Concat(New(Less[int]))
+	checkElements(t, a, 0, 1, 4, 5)
+	checkElements(t, b)
+	if a.ElementAt(2) != e4 {
+		t.Fatalf("\nexpected element to move with its item\n")
+	}
+
+	a.SpliceAt(2, New(Less[int], 2, 3)).SpliceAt(0, New(Less[int], -1)).SpliceAt(a.Len(), New(Less[int], 6))
+	checkElements(t, a, -1, 0, 1, 2, 3, 4, 5, 6)
+
+	ext := a.Extract(2, 6)
+	checkElements(t, ext, 1, 2, 3, 4)
+	checkElements(t, a, -1, 0, 5, 6)
+	checkElements(t, a.Extract(0, 4), -1, 0, 5, 6)
+	checkElements(t, a)
+	checkElements(t, a.Extract(0, 0))
+}
+
+// TestReverseRotateSplit relinks a list in place.
+func TestReverseRotateSplit(t *testing.T) {
+	ls := New(Less[int], 0, 1, 2, 3, 4)
+	checkElements(t, ls.Reverse(), 4, 3, 2, 1, 0)
+	checkElements(t, ls.Reverse().Rotate(2), 2, 3, 4, 0, 1)
+	checkElements(t, ls.Rotate(-2), 0, 1, 2, 3, 4)
+	checkElements(t, ls.Rotate(5), 0, 1, 2, 3, 4)
+	checkElements(t, New(Less[int]).Reverse().Rotate(3))
+
+	front, back := ls.SplitAt(2)
+	checkElements(t, front, 0, 1)
+	checkElements(t, back, 2, 3, 4)
+	if front != ls {
+		t.Fatalf("\nexpected the front to be the list\n")
+	}
+
+	front, back = back.SplitAt(3)
+	checkElements(t, front, 2, 3, 4)
+	checkElements(t, back)
+}
+
+// TestIter ranges over a list in both directions and collects it from a sequence.
+func TestIter(t *testing.T) {
+	var (
+		exp = []int{0, 1, 2, 3, 4}
+		ls  = Collect(slices.Values(exp), Less[int])
+	)
+
+	if rec := slices.Collect(ls.Values()); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	for i, v := range ls.All() {
+		if exp[i] != v {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp[i], v)
+		}
+	}
+
+	rec := make([]int, 0, len(exp))
+	for i, v := range ls.Backward() {
+		if exp[i] != v {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp[i], v)
+		}
+
+		if v == 1 {
+			break
+		}
+
+		rec = append(rec, v)
+	}
+
+	if exp := []int{4, 3, 2}; !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+}
+
 // TestInsertRemove tests the manual alteration of a list's state.
 func TestInsertRemove(t *testing.T) {
 	ls := New(Ints, 0, 1, 0, 2, 0)
@@ -130,10 +706,10 @@ func TestMapFilterReduce(t *testing.T) {
 	for n := 1; n <= 256; n <<= 1 {
 		// Computing sums
 		var (
-			exp int         = n * (n + 1) / 2                                                 // 1+2+...+n
-			gen Generator   = func(i int) interface{} { return i + 1 }                        // Generates {1, 2, 3, ..., 256}
-			red Reducer     = func(x, y interface{}) interface{} { return x.(int) + y.(int) } //
-			rec interface{} = Generate(n, gen, Ints).Reduce(red)                              // Returns interface{}, not int on purpose
+			exp int                               = n * (n + 1) / 2                                                 // 1+2+...+n
+			gen Generator[interface{}]            = func(i int) interface{} { return i + 1 }                        // Generates {1, 2, 3, ..., 256}
+			red Reducer[interface{}, interface{}] = func(x, y interface{}) interface{} { return x.(int) + y.(int) } //
+			rec interface{}                       = Generate(n, gen, Ints).Reduce(red)                              // Returns interface{}, not int on purpose
 		)
 
 		if exp != rec {
@@ -152,9 +728,9 @@ func TestMapFilterReduce(t *testing.T) {
 		}
 
 		var (
-			gen  Generator     = func(i int) interface{} { return i + 1 }                  // Generate {1, 2, 3, ..., 256}
-			fltr Filterer      = func(x interface{}) bool { return math.IsPrime(x.(int)) } // Filter primes
-			rec  []interface{} = Generate(256, gen, Ints).Filter(fltr).Slice()
+			gen  Generator[interface{}] = func(i int) interface{} { return i + 1 }                  // Generate {1, 2, 3, ..., 256}
+			fltr Filterer[interface{}]  = func(x interface{}) bool { return math.IsPrime(x.(int)) } // Filter primes
+			rec  []interface{}          = Generate(256, gen, Ints).Filter(fltr).Slice()
 		)
 
 		if len(exp) != len(rec) {
@@ -171,11 +747,11 @@ func TestMapFilterReduce(t *testing.T) {
 	{
 		// Joining directories into a path
 		var (
-			values []string    = []string{"a", "b", "c", "d", "e"}
-			exp    string      = strings.Join(values, "/")
-			gen    Generator   = func(i int) interface{} { return string('a' + byte(i)) }
-			red    Reducer     = func(x, y interface{}) interface{} { return x.(string) + "/" + y.(string) }
-			rec    interface{} = Generate(len(values), gen, Strings).Reduce(red)
+			values []string                          = []string{"a", "b", "c", "d", "e"}
+			exp    string                            = strings.Join(values, "/")
+			gen    Generator[interface{}]            = func(i int) interface{} { return string('a' + byte(i)) }
+			red    Reducer[interface{}, interface{}] = func(x, y interface{}) interface{} { return x.(string) + "/" + y.(string) }
+			rec    interface{}                       = Generate(len(values), gen, Strings).Reduce(red)
 		)
 
 		if exp != rec {
@@ -186,10 +762,10 @@ func TestMapFilterReduce(t *testing.T) {
 
 func BenchmarkList(b *testing.B) {
 	var (
-		n  int           = 256
-		s  int           = 8
-		vs []interface{} = make([]interface{}, n)
-		f  Lesser        = Ints
+		n  int                 = 256
+		s  int                 = 8
+		vs []interface{}       = make([]interface{}, n)
+		f  Lesser[interface{}] = Ints
 	)
 
 	{
@@ -231,7 +807,7 @@ func BenchmarkList(b *testing.B) {
 	}
 }
 
-func benchmarkList(b *testing.B, less Lesser, values ...interface{}) bool {
+func benchmarkList(b *testing.B, less Lesser[interface{}], values ...interface{}) bool {
 	f := func(b0 *testing.B) {
 		for i := 0; i < b0.N; i++ {
 			ls := New(less)
@@ -280,3 +856,135 @@ func benchmarkSliceAppend(b *testing.B, values ...interface{}) bool {
 
 	return b.Run(fmt.Sprintf("Appended slice of %d values", len(values)), f)
 }
+
+// FuzzList runs a sequence of operations, decoded from the fuzzed bytes, on a list and on a slice model of it.
+// The list must be valid and hold the model's values after every operation. The fuzzer minimizes failing inputs,
+// so a failure reports a minimal sequence of operations.
+func FuzzList(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{4, 1, 2, 0, 0, 3, 1, 0, 2, 8})
+	f.Add([]byte{3, 5, 5, 2, 5, 4, 7, 7, 6, 0, 3, 1, 1, 5, 7, 1, 3})
+	f.Add([]byte{0, 0, 1, 0, 0, 2, 0, 2, 3, 8, 2, 2, 1, 0, 5, 5})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var (
+			ls    = New(Ints)
+			model []interface{}
+			next  = func() int {
+				if len(data) == 0 {
+					return 0
+				}
+
+				b := data[0]
+				data = data[1:]
+				return int(b)
+			}
+		)
+
+		var ops []string
+		for 0 < len(data) {
+			switch op := next() % 14; {
+			case op == 0:
+				i, v := next()%(len(model)+1), next()%8
+				ls.InsertAt(i, v)
+				model = slices.Insert(model, i, interface{}(v))
+				ops = append(ops, fmt.Sprintf("InsertAt(%d, %d)", i, v))
+			case op == 1 && 0 < len(model):
+				i := next() % len(model)
+				if v := ls.RemoveAt(i); v != model[i] {
+					t.Fatalf("\n%v\nexpected %v\nreceived %v\n", ops, model[i], v)
+				}
+
+				model = slices.Delete(model, i, i+1)
+				ops = append(ops, fmt.Sprintf("RemoveAt(%d)", i))
+			case op == 2:
+				v := next() % 8
+				ls.Remove(v)
+				model = slices.DeleteFunc(model, func(x interface{}) bool { return x == v })
+				ops = append(ops, fmt.Sprintf("Remove(%d)", v))
+			case op == 3:
+				v, w := next()%8, next()%8
+				ls.Prepend(v, w)
+				model = append([]interface{}{w, v}, model...)
+				ops = append(ops, fmt.Sprintf("Prepend(%d, %d)", v, w))
+			case op == 4:
+				v, w := next()%8, next()%8
+				ls.Append(v, w)
+				model = append(model, v, w)
+				ops = append(ops, fmt.Sprintf("Append(%d, %d)", v, w))
+			case op == 5 && 0 < len(model):
+				if v := ls.Pop(); v != model[len(model)-1] {
+					t.Fatalf("\n%v\nexpected %v\nreceived %v\n", ops, model[len(model)-1], v)
+				}
+
+				model = model[:len(model)-1]
+				ops = append(ops, "Pop()")
+			case op == 6 && 0 < len(model):
+				i, j := next()%len(model), next()%len(model)
+				ls.Swap(i, j)
+				model[i], model[j] = model[j], model[i]
+				ops = append(ops, fmt.Sprintf("Swap(%d, %d)", i, j))
+			case op == 7:
+				i := next() % (len(model) + 1)
+				j := i + next()%(len(model)-i+1)
+				sub := ls.SubList(i, j)
+				ops = append(ops, fmt.Sprintf("SubList(%d, %d)", i, j))
+				if err := sub.Validate(); err != nil {
+					t.Fatalf("\n%v\n%v\n", ops, err)
+				}
+
+				if exp, rec := model[i:j], sub.Slice(); !slices.Equal(exp, rec) {
+					t.Fatalf("\n%v\nexpected %v\nreceived %v\n", ops, exp, rec)
+				}
+			case op == 8:
+				ls.Sort()
+				slices.SortFunc(model, func(x, y interface{}) int { return x.(int) - y.(int) })
+				ops = append(ops, "Sort()")
+			case op == 9:
+				v, w := next()%8, next()%8
+				ls.Concat(New[interface{}](Ints, v, w))
+				model = append(model, v, w)
+				ops = append(ops, fmt.Sprintf("Concat(%d, %d)", v, w))
+			case op == 10:
+				i, v, w := next()%(len(model)+1), next()%8, next()%8
+				ls.SpliceAt(i, New[interface{}](Ints, v, w))
+				model = slices.Insert(model, i, interface{}(v), interface{}(w))
+				ops = append(ops, fmt.Sprintf("SpliceAt(%d, %d, %d)", i, v, w))
+			case op == 11:
+				i := next() % (len(model) + 1)
+				j := i + next()%(len(model)-i+1)
+				ext := ls.Extract(i, j)
+				ops = append(ops, fmt.Sprintf("Extract(%d, %d)", i, j))
+				if err := ext.Validate(); err != nil {
+					t.Fatalf("\n%v\n%v\n", ops, err)
+				}
+
+				if exp, rec := model[i:j], ext.Slice(); !slices.Equal(exp, rec) {
+					t.Fatalf("\n%v\nexpected %v\nreceived %v\n", ops, exp, rec)
+				}
+
+				model = slices.Delete(model, i, j)
+			case op == 12:
+				ls.Reverse()
+				slices.Reverse(model)
+				ops = append(ops, "Reverse()")
+			case op == 13:
+				k := next() - 128
+				ls.Rotate(k)
+				if 0 < len(model) {
+					r := (k%len(model) + len(model)) % len(model)
+					model = append(model[r:], model[:r]...)
+				}
+
+				ops = append(ops, fmt.Sprintf("Rotate(%d)", k))
+			}
+
+			if err := ls.Validate(); err != nil {
+				t.Fatalf("\n%v\n%v\n", ops, err)
+			}
+
+			if rec := ls.Slice(); !slices.Equal(model, rec) {
+				t.Fatalf("\n%v\nexpected %v\nreceived %v\n", ops, model, rec)
+			}
+		}
+	})
+}
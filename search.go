@@ -0,0 +1,129 @@
+package list
+
+// BinarySearch returns the index of the first value equal to a value and true,
+// or the index the value would be inserted at and false. A value is equal to
+// another if neither is less than the other. Uses O(log n) comparisons and
+// O(n) steps. Assumes the list is sorted and less is set.
+func (ls *List[T]) BinarySearch(value T) (int, bool) {
+	i, itm := ls.search(func(x T) bool { return !ls.less(x, value) })
+	return i, itm != nil && !ls.less(value, itm.value)
+}
+
+// BinarySearchFunc returns the least index for which f returns true, or the
+// length of the list if there is none. Assumes f returns false for a prefix of
+// the list and true for the rest.
+func (ls *List[T]) BinarySearchFunc(f Filterer[T]) int {
+	i, _ := ls.search(f)
+	return i
+}
+
+// Contains returns true if a value is found in the list. Values are compared
+// as in Equal.
+func (ls *List[T]) Contains(value T) bool {
+	_, ok := ls.Search(value)
+	return ok
+}
+
+// CountFunc returns the number of values for which f returns true.
+func (ls *List[T]) CountFunc(f Filterer[T]) int {
+	var n int
+	for itm := ls.head; itm != nil; itm = itm.next {
+		if f(itm.value) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// FindAll returns the indices of the values for which f returns true.
+func (ls *List[T]) FindAll(f Filterer[T]) []int {
+	var indices []int
+	for i, itm := 0, ls.head; itm != nil; i, itm = i+1, itm.next {
+		if f(itm.value) {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
+// IndexFunc returns the index of the first value for which f returns true, or
+// -1 if there is none.
+func (ls *List[T]) IndexFunc(f Filterer[T]) int {
+	for i, itm := 0, ls.head; itm != nil; i, itm = i+1, itm.next {
+		if f(itm.value) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// InsertSorted inserts a value after all values not greater than it and
+// returns its element. Assumes the list is sorted and less is set.
+func (ls *List[T]) InsertSorted(value T) *Element[T] {
+	if _, itm := ls.search(func(x T) bool { return ls.less(value, x) }); itm != nil {
+		return ls.InsertBefore(value, itm)
+	}
+
+	return ls.PushBack(value)
+}
+
+// LastIndex returns the index of the last value equal to a value, or -1 if
+// there is none. Values are compared as in Equal, walking from the tail.
+func (ls *List[T]) LastIndex(value T) int {
+	eq := equaler[T]()
+	return ls.LastIndexFunc(func(x T) bool { return eq(value, x) })
+}
+
+// LastIndexFunc returns the index of the last value for which f returns true,
+// or -1 if there is none. The list is walked from the tail.
+func (ls *List[T]) LastIndexFunc(f Filterer[T]) int {
+	for i, itm := ls.length-1, ls.tail; itm != nil; i, itm = i-1, itm.prev {
+		if f(itm.value) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LowerBound returns the index of the first value not less than a value, or the
+// length of the list if there is none. Assumes the list is sorted and less is
+// set.
+func (ls *List[T]) LowerBound(value T) int {
+	return ls.BinarySearchFunc(func(x T) bool { return !ls.less(x, value) })
+}
+
+// search returns the least index for which f returns true and its element, or
+// the length of the list and nil if there is none. A cursor seeks each
+// midpoint from the previous one, so the steps walked total O(n).
+func (ls *List[T]) search(f Filterer[T]) (int, *Element[T]) {
+	var (
+		c      = ls.Cursor()
+		lo, hi = 0, ls.length
+	)
+
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if f(c.Seek(mid).Value()) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	if lo == ls.length {
+		return lo, nil
+	}
+
+	return lo, c.Seek(lo).itm
+}
+
+// UpperBound returns the index of the first value greater than a value, or the
+// length of the list if there is none. Assumes the list is sorted and less is
+// set.
+func (ls *List[T]) UpperBound(value T) int {
+	return ls.BinarySearchFunc(func(x T) bool { return ls.less(value, x) })
+}
@@ -0,0 +1,81 @@
+package list
+
+// Sort a list in O(n log n) time. Items are relinked rather than having their
+// values swapped. As with SortStable, equal values keep their original order.
+// Assumes less is set.
+func (ls *List[T]) Sort() *List[T] {
+	ls.mergeSort(ls.less)
+	return ls
+}
+
+// SortFunc sorts a list given a less function. The list's less function is
+// neither used nor changed.
+func (ls *List[T]) SortFunc(less Lesser[T]) *List[T] {
+	ls.mergeSort(less)
+	return ls
+}
+
+// SortStable sorts a list, keeping equal values in their original order.
+// Assumes less is set.
+func (ls *List[T]) SortStable() *List[T] {
+	ls.mergeSort(ls.less)
+	return ls
+}
+
+// SortStableFunc sorts a list given a less function, keeping equal values in
+// their original order. The list's less function is neither used nor changed.
+func (ls *List[T]) SortStableFunc(less Lesser[T]) *List[T] {
+	ls.mergeSort(less)
+	return ls
+}
+
+// mergeSort sorts a list in place with a bottom-up merge sort given a less
+// function. Runs of width 1, 2, 4, ... are merged until a single run remains.
+// Ties are taken from the left run, so the sort is stable.
+func (ls *List[T]) mergeSort(less Lesser[T]) {
+	if ls.length < 2 {
+		return
+	}
+
+	head, tail := ls.head, ls.tail
+	for width := 1; width < ls.length; width <<= 1 {
+		var newHead, newTail *Element[T]
+		for left := head; left != nil; {
+			right, leftLen, rightLen := left, 0, width
+			for ; leftLen < width && right != nil; leftLen++ {
+				right = right.next
+			}
+
+			for 0 < leftLen || (0 < rightLen && right != nil) {
+				var itm *Element[T]
+				switch {
+				case leftLen == 0:
+					itm, right = right, right.next
+					rightLen--
+				case rightLen == 0, right == nil, !less(right.value, left.value):
+					itm, left = left, left.next
+					leftLen--
+				default:
+					itm, right = right, right.next
+					rightLen--
+				}
+
+				if newTail == nil {
+					newHead = itm
+				} else {
+					newTail.next = itm
+				}
+
+				itm.prev = newTail
+				newTail = itm
+			}
+
+			left = right
+		}
+
+		newTail.next = nil
+		head, tail = newHead, newTail
+	}
+
+	ls.head, ls.tail = head, tail
+}
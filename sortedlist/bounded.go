@@ -0,0 +1,93 @@
+package sortedlist
+
+import "cmp"
+
+// Eviction determines which value a bounded sorted list drops when it exceeds
+// its capacity.
+type Eviction int
+
+const (
+	// EvictMin drops the least value, keeping the greatest values.
+	EvictMin Eviction = iota
+
+	// EvictMax drops the greatest value, keeping the least values.
+	EvictMax
+)
+
+// NewBounded creates a new sorted list of ordered values holding at most k
+// values. Values beyond the capacity are dropped given an eviction.
+func NewBounded[T cmp.Ordered](k int, e Eviction, values ...T) *SortedList[T] {
+	return NewBoundedFunc(cmp.Compare[T], k, e, values...)
+}
+
+// NewBoundedFunc creates a new sorted list of values ordered by a comparer
+// holding at most k values. Values beyond the capacity are dropped given an
+// eviction.
+func NewBoundedFunc[T any](f Comparer[T], k int, e Eviction, values ...T) *SortedList[T] {
+	return NewFunc(f).SetBound(k, e).Insert(values...)
+}
+
+// Capacity returns the maximum number of values a sorted list holds, or zero
+// if it is unbounded.
+func (sl *SortedList[T]) Capacity() int {
+	return sl.capacity
+}
+
+// evict drops the least or greatest value given the list's eviction.
+func (sl *SortedList[T]) evict() T {
+	if sl.eviction == EvictMax {
+		return sl.RemoveAt(sl.length - 1)
+	}
+
+	return sl.RemoveAt(0)
+}
+
+// InsertEvict inserts a value in O(log n) expected time. If the list exceeds
+// its capacity, a value is dropped given the list's eviction and returned with
+// true. The dropped value may be the value inserted.
+func (sl *SortedList[T]) InsertEvict(value T) (T, bool) {
+	if sl.rejects(value) {
+		return value, true
+	}
+
+	sl.insertValue(value)
+	if 0 < sl.capacity && sl.capacity < sl.length {
+		return sl.evict(), true
+	}
+
+	var evicted T
+	return evicted, false
+}
+
+// rejects returns true if a sorted list is full and a value would be dropped
+// as soon as it is inserted.
+func (sl *SortedList[T]) rejects(value T) bool {
+	switch {
+	case sl.capacity == 0 || sl.length < sl.capacity:
+		return false
+	case sl.eviction == EvictMax:
+		return 0 < sl.compare(value, sl.tail.value)
+	default:
+		return sl.compare(value, sl.head.value) < 0
+	}
+}
+
+// SetBound sets the capacity of a sorted list to k values and the eviction
+// dropping values beyond it. A capacity of zero is unbounded. Values beyond the
+// capacity are dropped immediately.
+func (sl *SortedList[T]) SetBound(k int, e Eviction) *SortedList[T] {
+	if k < 0 {
+		panic("capacity out of range")
+	}
+
+	sl.capacity, sl.eviction = k, e
+	sl.trim()
+	return sl
+}
+
+// trim drops values until a sorted list is within its capacity.
+func (sl *SortedList[T]) trim() {
+	for 0 < sl.capacity && sl.capacity < sl.length {
+		sl.evict()
+	}
+}
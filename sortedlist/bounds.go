@@ -0,0 +1,91 @@
+package sortedlist
+
+import "iter"
+
+// Bound determines whether the endpoints of a range are included.
+type Bound int
+
+const (
+	// Open excludes both endpoints: (lo, hi).
+	Open Bound = iota
+
+	// LeftClosed includes the lower endpoint: [lo, hi).
+	LeftClosed
+
+	// RightClosed includes the upper endpoint: (lo, hi].
+	RightClosed
+
+	// Closed includes both endpoints: [lo, hi].
+	Closed
+)
+
+// Ceiling returns the least value greater than or equal to a value. Returns
+// false if there is no such value.
+func (sl *SortedList[T]) Ceiling(value T) (T, bool) {
+	p := sl.searchLower(value)
+	itm, _ := sl.forward(p.items[0], 0)
+	return valueOf(itm)
+}
+
+// Floor returns the greatest value less than or equal to a value. Returns
+// false if there is no such value.
+func (sl *SortedList[T]) Floor(value T) (T, bool) {
+	return valueOf(sl.searchUpper(value).items[0])
+}
+
+// Higher returns the least value greater than a value. Returns false if there
+// is no such value.
+func (sl *SortedList[T]) Higher(value T) (T, bool) {
+	p := sl.searchUpper(value)
+	itm, _ := sl.forward(p.items[0], 0)
+	return valueOf(itm)
+}
+
+// Lower returns the greatest value less than a value. Returns false if there
+// is no such value.
+func (sl *SortedList[T]) Lower(value T) (T, bool) {
+	return valueOf(sl.searchLower(value).items[0])
+}
+
+// Max returns the greatest value. Returns false if the list is empty.
+func (sl *SortedList[T]) Max() (T, bool) {
+	return valueOf(sl.tail)
+}
+
+// Min returns the least value. Returns false if the list is empty.
+func (sl *SortedList[T]) Min() (T, bool) {
+	return valueOf(sl.head)
+}
+
+// Range returns an iterator over the values between lo and hi in ascending
+// order. The bound determines whether lo and hi are included.
+func (sl *SortedList[T]) Range(lo, hi T, b Bound) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var p path[T]
+		if b&LeftClosed != 0 {
+			p = sl.searchLower(lo)
+		} else {
+			p = sl.searchUpper(lo)
+		}
+
+		for itm, _ := sl.forward(p.items[0], 0); itm != nil; itm = itm.next {
+			if r := sl.compare(itm.value, hi); 0 < r || r == 0 && b&RightClosed == 0 {
+				return
+			}
+
+			if !yield(itm.value) {
+				return
+			}
+		}
+	}
+}
+
+// valueOf returns the value of an item. Returns false if the item is nil.
+func valueOf[T any](itm *item[T]) (T, bool) {
+	if itm == nil {
+		var value T
+		return value, false
+	}
+
+	return itm.value, true
+}
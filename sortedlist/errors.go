@@ -0,0 +1,12 @@
+package sortedlist
+
+import "errors"
+
+var (
+	// ErrCorrupted is returned when a sorted list's references or length are
+	// inconsistent.
+	ErrCorrupted = errors.New("sortedlist: corrupted list")
+
+	// ErrUnsorted is returned when a sorted list's values are out of order.
+	ErrUnsorted = errors.New("sortedlist: unsorted list")
+)
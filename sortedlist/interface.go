@@ -4,3 +4,10 @@ package sortedlist
 type Comparable interface {
 	Compare(c Comparable) int
 }
+
+// Comparer defines the comparison of two values. It returns a negative number
+// if a < b, a positive number if b < a, and zero otherwise.
+type Comparer[T any] func(a, b T) int
+
+// compareComparables (type Comparer) compares two comparable values.
+func compareComparables(a, b Comparable) int { return a.Compare(b) }
@@ -1,7 +1,9 @@
 package sortedlist
 
-// item ...
-type item struct {
-	value      Comparable
-	prev, next *item
+// item holds a value, references its previous and next items, if any, and
+// references items further ahead on each express level it belongs to.
+type item[T any] struct {
+	value      T
+	prev, next *item[T]
+	skips      []skip[T]
 }
@@ -0,0 +1,58 @@
+package sortedlist
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Collect a sorted list of ordered values from a sequence.
+func Collect[T cmp.Ordered](seq iter.Seq[T]) *SortedList[T] {
+	return CollectFunc(cmp.Compare[T], seq)
+}
+
+// CollectFunc collects a sorted list of values ordered by a comparer from a
+// sequence.
+func CollectFunc[T any](f Comparer[T], seq iter.Seq[T]) *SortedList[T] {
+	sl := NewFunc(f)
+	for value := range seq {
+		sl.Insert(value)
+	}
+
+	return sl
+}
+
+// All returns an iterator over the indices and values of a sorted list in
+// ascending order.
+func (sl *SortedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, itm := 0, sl.head; itm != nil; i, itm = i+1, itm.next {
+			if !yield(i, itm.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indices and values of a sorted list
+// in descending order.
+func (sl *SortedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, itm := sl.length-1, sl.tail; itm != nil; i, itm = i-1, itm.prev {
+			if !yield(i, itm.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of a sorted list in ascending
+// order.
+func (sl *SortedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for itm := sl.head; itm != nil; itm = itm.next {
+			if !yield(itm.value) {
+				return
+			}
+		}
+	}
+}
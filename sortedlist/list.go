@@ -1,97 +1,119 @@
 package sortedlist
 
 import (
+	"cmp"
 	"fmt"
 	"strings"
 )
 
-// SortedList is a doubly linked list of sorted values.
-type SortedList struct {
-	head, tail *item
+// SortedList is a doubly linked list of sorted values. Items are indexed by an
+// indexable skip list, so searching, inserting, and removing take O(log n)
+// expected time. A sorted list must be created by New, NewFunc, NewComparable,
+// or another constructor; the zero value has no comparer and panics on use.
+type SortedList[T any] struct {
+	head, tail *item[T]
+	skips      []skip[T]
 	length     int
+	compare    Comparer[T]
+	policy     DuplicatePolicy
+	capacity   int
+	eviction   Eviction
 }
 
-// New creates a new sorted list of values.
-func New(values ...Comparable) *SortedList {
-	var sl SortedList
+// New creates a new sorted list of ordered values.
+func New[T cmp.Ordered](values ...T) *SortedList[T] {
+	return NewFunc(cmp.Compare[T], values...)
+}
+
+// NewComparable creates a new sorted list of comparable values.
+func NewComparable(values ...Comparable) *SortedList[Comparable] {
+	return NewFunc(compareComparables, values...)
+}
+
+// NewFunc creates a new sorted list of values ordered by a comparer.
+func NewFunc[T any](f Comparer[T], values ...T) *SortedList[T] {
+	sl := SortedList[T]{compare: f}
 	return sl.Insert(values...)
 }
 
-// Contains returns true if a value is found in a sorted list.
-func (sl *SortedList) Contains(value Comparable) bool {
-	return sl.find(value) != nil
+// NewUnique creates a new sorted list of ordered values holding no two equal
+// values. Equal values are rejected or replaced given a policy.
+func NewUnique[T cmp.Ordered](p DuplicatePolicy, values ...T) *SortedList[T] {
+	return NewUniqueFunc(cmp.Compare[T], p, values...)
 }
 
-// find an item containing a value.
-func (sl *SortedList) find(value Comparable) *item {
-	if 0 < sl.length {
-		for itm := sl.head; itm != nil; itm = itm.next {
-			r := itm.value.Compare(value)
-			switch {
-			case r < 0: // Continue
-			case 0 < r:
-				return nil
-			default:
-				return itm
-			}
-		}
+// NewUniqueFunc creates a new sorted list of values ordered by a comparer
+// holding no two equal values. Equal values are rejected or replaced given a
+// policy.
+func NewUniqueFunc[T any](f Comparer[T], p DuplicatePolicy, values ...T) *SortedList[T] {
+	sl := SortedList[T]{compare: f, policy: p}
+	return sl.Insert(values...)
+}
+
+// comparer returns the comparer of a sorted list. Panics if there is none, as
+// in the zero value.
+func (sl *SortedList[T]) comparer() Comparer[T] {
+	if sl.compare == nil {
+		panic("sortedlist: no comparer")
 	}
 
-	return nil
+	return sl.compare
 }
 
-// Insert several values.
-func (sl *SortedList) Insert(values ...Comparable) *SortedList {
-	for i := 0; i < len(values); i++ {
-		switch {
-		case sl.length == 0:
-			sl.head = &item{value: values[i]}
-			sl.tail = sl.head
-		case 0 < sl.head.value.Compare(values[i]):
-			sl.head.prev = &item{
-				value: values[i],
-				next:  sl.head,
-			}
+// Contains returns true if a value is found in a sorted list in O(log n)
+// expected time.
+func (sl *SortedList[T]) Contains(value T) bool {
+	return sl.find(value) != nil
+}
 
-			sl.head = sl.head.prev
-		default:
-			itm := sl.tail
-			for ; itm != nil && 0 < itm.value.Compare(values[i]); itm = itm.prev {
-			}
+// find the first item containing a value.
+func (sl *SortedList[T]) find(value T) *item[T] {
+	p := sl.searchLower(value)
+	if itm, _ := sl.forward(p.items[0], 0); itm != nil && sl.compare(itm.value, value) == 0 {
+		return itm
+	}
 
-			if itm == sl.tail {
-				sl.tail.next = &item{
-					value: values[i],
-					prev:  sl.tail,
-				}
-
-				sl.tail = sl.tail.next
-			} else {
-				itm.next.prev = &item{
-					value: values[i],
-					prev:  itm,
-					next:  itm.next,
-				}
-
-				itm.next = itm.next.prev
-			}
-		}
+	return nil
+}
 
-		sl.length++
+// Insert several values in O(log n) expected time each. Values equal to one
+// already held are handled by the list's duplicate policy, and values beyond
+// its capacity are evicted.
+func (sl *SortedList[T]) Insert(values ...T) *SortedList[T] {
+	for i := 0; i < len(values); i++ {
+		sl.InsertEvict(values[i])
 	}
 
 	return sl
 }
 
+// insertValue inserts a value given the list's duplicate policy, ignoring the
+// capacity.
+func (sl *SortedList[T]) insertValue(value T) {
+	switch sl.policy {
+	case RejectDuplicates:
+		sl.insertUnique(value)
+	case ReplaceDuplicates:
+		sl.upsert(value)
+	default:
+		sl.insert(value)
+	}
+}
+
 // Length of the sorted list.
-func (sl *SortedList) Length() int {
+func (sl *SortedList[T]) Length() int {
 	return sl.length
 }
 
-// Map comparable values.
-func (sl *SortedList) Map() map[int]Comparable {
+// like returns an empty sorted list ordered and configured as a sorted list.
+func (sl *SortedList[T]) like() *SortedList[T] {
+	return &SortedList[T]{compare: sl.compare, policy: sl.policy, capacity: sl.capacity, eviction: sl.eviction}
+}
+
+// Map values to their indices.
+func (sl *SortedList[T]) Map() map[int]T {
 	var (
-		m = make(map[int]Comparable)
+		m = make(map[int]T)
 		i int
 	)
 
@@ -104,84 +126,34 @@ func (sl *SortedList) Map() map[int]Comparable {
 }
 
 // Remove several values. If duplicates exist, they will all be removed.
-func (sl *SortedList) Remove(values ...Comparable) *SortedList {
+func (sl *SortedList[T]) Remove(values ...T) *SortedList[T] {
 	for i := 0; i < len(values); i++ {
-		for itm := sl.find(values[i]); itm != nil; itm = itm.next {
-			switch {
-			case sl.length == 1:
-				sl.head = nil
-				sl.tail = nil
-			case itm == sl.head:
-				sl.head = sl.head.next
-			case itm == sl.tail:
-				sl.tail = sl.tail.prev
-			default:
-				itm.prev.next = itm.next
-				itm.next.prev = itm.prev
-			}
-
-			sl.length--
-			if itm.next != nil && itm.next.value.Compare(values[i]) != 0 {
+		p := sl.searchLower(values[i])
+		for {
+			if itm, _ := sl.forward(p.items[0], 0); itm == nil || sl.compare(itm.value, values[i]) != 0 {
 				break
 			}
+
+			sl.unlink(&p)
 		}
 	}
 
 	return sl
 }
 
-// RemoveAt the ith value.
-func (sl *SortedList) RemoveAt(i int) Comparable {
+// RemoveAt the ith value in O(log n) expected time.
+func (sl *SortedList[T]) RemoveAt(i int) T {
 	if i < 0 || sl.length <= i {
 		panic("index out of range")
 	}
 
-	switch i {
-	case 0:
-		if sl.length == 1 {
-			value := sl.head.value
-			sl.head = nil
-			sl.tail = nil
-			sl.length--
-			return value
-		}
-
-		value := sl.head.value
-		sl.head = sl.head.next
-		sl.length--
-		return value
-	case sl.length - 1:
-		if sl.length == 1 {
-			value := sl.head.value
-			sl.head = nil
-			sl.tail = nil
-			sl.length--
-			return value
-		}
-
-		value := sl.tail.value
-		sl.tail = sl.tail.prev
-		sl.length--
-		return value
-	default:
-		for itm := sl.head; itm != nil && i < sl.length; itm = itm.next {
-			if i == 0 {
-				itm.prev.next = itm.next
-				itm.next.prev = itm.prev
-				sl.length--
-				return itm.value
-			}
-
-			i--
-		}
-
-		return nil
-	}
+	p := sl.searchIndex(i)
+	return sl.unlink(&p).value
 }
 
-// Slice comparable values.
-func (sl *SortedList) Slice() []Comparable {
-	s := make([]Comparable, 0, sl.length)
+// Slice values.
+func (sl *SortedList[T]) Slice() []T {
+	s := make([]T, 0, sl.length)
 	for itm := sl.head; itm != nil; itm = itm.next {
 		s = append(s, itm.value)
 	}
@@ -190,7 +162,7 @@ func (sl *SortedList) Slice() []Comparable {
 }
 
 // String represents a formatted sorted list.
-func (sl *SortedList) String() string {
+func (sl *SortedList[T]) String() string {
 	s := make([]string, 0, sl.length)
 	for itm := sl.head; itm != nil; itm = itm.next {
 		s = append(s, fmt.Sprintf("%v", itm.value))
@@ -1,8 +1,11 @@
 package sortedlist
 
 import (
+	"errors"
 	"fmt"
+	"iter"
 	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -51,13 +54,13 @@ func TestSortedList(t *testing.T) {
 	)
 
 	for i := 0; i < iters; i++ {
-		sl := New()
+		sl := New[int]()
 		for j := 0; j < numItems; j++ {
-			sl.Insert(testInt(rand.Int()))
+			sl.Insert(rand.Int())
 		}
 
 		for itm := sl.head; itm != nil && itm.next != nil; itm = itm.next {
-			if 0 < itm.value.Compare(itm.next.value) {
+			if itm.next.value < itm.value {
 				t.Fatalf("expected %v < %v\n", itm.value, itm.next.value)
 			}
 		}
@@ -75,7 +78,7 @@ func TestRemove(t *testing.T) {
 	)
 
 	for i := 0; i < iters; i++ {
-		sl := New()
+		sl := NewComparable()
 		values := make([]testInt, 0, numItems)
 		for j := 0; j < numItems; j++ {
 			values = append(values, testInt(rand.Intn(10)))
@@ -96,7 +99,7 @@ func TestRemove(t *testing.T) {
 }
 
 func TestSortedList2(t *testing.T) {
-	sl := New(
+	sl := NewComparable(
 		&testStruct{key: 2, value: "two"},
 		&testStruct{key: 4, value: "four"},
 		&testStruct{key: 3, value: "three"},
@@ -110,3 +113,446 @@ func TestSortedList2(t *testing.T) {
 		}
 	}
 }
+
+func TestNewFunc(t *testing.T) {
+	var (
+		f  Comparer[string] = func(a, b string) int { return len(a) - len(b) }
+		sl                  = NewFunc(f, "ccc", "a", "bb")
+	)
+
+	if exp, rec := "a,bb,ccc", sl.String(); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	if v := sl.Contains("xx"); !v {
+		t.Fatalf("\nexpected %t\nreceived %t\n", true, v)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	defer func() {
+		if exp, rec := "sortedlist: no comparer", recover(); exp != rec {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+		}
+	}()
+
+	var sl SortedList[int]
+	sl.Insert(1, 2)
+}
+
+func TestIter(t *testing.T) {
+	sl := Collect(slices.Values([]int{3, 5, 1, 4, 2}))
+	sl.Remove(1, 5)
+	if exp, rec := []int{2, 3, 4}, slices.Collect(sl.Values()); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	for i, v := range sl.All() {
+		if exp := i + 2; exp != v {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp, v)
+		}
+	}
+
+	var rec []int
+	for i, v := range sl.Backward() {
+		if exp := i + 2; exp != v {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp, v)
+		}
+
+		rec = append(rec, v)
+	}
+
+	if exp := []int{4, 3, 2}; !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+}
+
+// TestSkipList compares random insertions and removals against a sorted slice.
+func TestSkipList(t *testing.T) {
+	var (
+		sl  = New[int]()
+		exp []int
+	)
+
+	for i := 0; i < 4096; i++ {
+		switch x := rand.Intn(256); {
+		case rand.Intn(3) != 0:
+			sl.Insert(x)
+			j, _ := slices.BinarySearch(exp, x+1)
+			exp = slices.Insert(exp, j, x)
+		case rand.Intn(2) == 0 && 0 < len(exp):
+			j := rand.Intn(len(exp))
+			if v := sl.RemoveAt(j); exp[j] != v {
+				t.Fatalf("\nexpected %d\nreceived %d\n", exp[j], v)
+			}
+
+			exp = slices.Delete(exp, j, j+1)
+		default:
+			sl.Remove(x)
+			exp = slices.DeleteFunc(exp, func(y int) bool { return x == y })
+		}
+
+		if rec := sl.Slice(); !slices.Equal(exp, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+		}
+
+		checkValid(t, sl)
+	}
+}
+
+// checkValid ensures a sorted list is structurally valid.
+func checkValid[T any](t *testing.T, sl *SortedList[T]) {
+	t.Helper()
+	if err := sl.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRank(t *testing.T) {
+	sl := New[int]()
+	for i := 100; 0 < i; i-- {
+		sl.Insert(i)
+	}
+
+	for i := 0; i < sl.Length(); i++ {
+		if exp, rec := i+1, sl.At(i); exp != rec {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+		}
+
+		if exp, rec := i, sl.Rank(i+1); exp != rec {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+		}
+	}
+
+	if exp, rec := 50, sl.Median(); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	if exp, rec := 99, sl.Percentile(99); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	if exp, rec := 1, sl.Percentile(0); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	if exp, rec := []int{25, 50, 75}, sl.Quantiles(4); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	if exp, rec := 100, sl.Rank(1000); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	sl := New(10, 20, 20, 30)
+	tests := []struct {
+		name string
+		f    func(int) (int, bool)
+		in   []int
+		exp  []int
+		ok   []bool
+	}{
+		{name: "Ceiling", f: sl.Ceiling, in: []int{5, 10, 15, 30, 35}, exp: []int{10, 10, 20, 30, 0}, ok: []bool{true, true, true, true, false}},
+		{name: "Floor", f: sl.Floor, in: []int{5, 10, 15, 30, 35}, exp: []int{0, 10, 10, 30, 30}, ok: []bool{false, true, true, true, true}},
+		{name: "Higher", f: sl.Higher, in: []int{5, 10, 20, 30}, exp: []int{10, 20, 30, 0}, ok: []bool{true, true, true, false}},
+		{name: "Lower", f: sl.Lower, in: []int{10, 20, 25, 35}, exp: []int{0, 10, 20, 30}, ok: []bool{false, true, true, true}},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < len(test.in); i++ {
+			if v, ok := test.f(test.in[i]); test.exp[i] != v || test.ok[i] != ok {
+				t.Fatalf("\n%s(%d)\nexpected (%d, %t)\nreceived (%d, %t)\n", test.name, test.in[i], test.exp[i], test.ok[i], v, ok)
+			}
+		}
+	}
+
+	if lo, _ := sl.Min(); lo != 10 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 10, lo)
+	}
+
+	if hi, _ := sl.Max(); hi != 30 {
+		t.Fatalf("\nexpected %d\nreceived %d\n", 30, hi)
+	}
+
+	if _, ok := New[int]().Min(); ok {
+		t.Fatalf("\nexpected %t\nreceived %t\n", false, ok)
+	}
+
+	ranges := []struct {
+		b   Bound
+		exp []int
+	}{
+		{b: Open, exp: []int{20, 20}},
+		{b: LeftClosed, exp: []int{10, 20, 20}},
+		{b: RightClosed, exp: []int{20, 20, 30}},
+		{b: Closed, exp: []int{10, 20, 20, 30}},
+	}
+
+	for _, r := range ranges {
+		if rec := slices.Collect(sl.Range(10, 30, r.b)); !slices.Equal(r.exp, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", r.exp, rec)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	var (
+		a = []int{1, 2, 2, 3, 5}
+		b = []int{2, 3, 3, 4}
+	)
+
+	tests := []struct {
+		name      string
+		f, with   func(sl, other *SortedList[int]) *SortedList[int]
+		exp, self []int
+	}{
+		{name: "Union", f: (*SortedList[int]).Union, with: (*SortedList[int]).UnionWith, exp: []int{1, 2, 2, 3, 3, 4, 5}, self: []int{1, 2, 2, 3, 3, 4, 5}},
+		{name: "Intersect", f: (*SortedList[int]).Intersect, with: (*SortedList[int]).IntersectWith, exp: []int{2, 3}, self: []int{2, 3}},
+		{name: "Difference", f: (*SortedList[int]).Difference, with: (*SortedList[int]).DifferenceWith, exp: []int{1, 2, 5}},
+		{name: "SymmetricDifference", f: (*SortedList[int]).SymmetricDifference, with: (*SortedList[int]).SymmetricDifferenceWith, exp: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		sl, other := New(a...), New(b...)
+		if rec := test.f(sl, other); !slices.Equal(test.exp, rec.Slice()) {
+			t.Fatalf("\n%s\nexpected %v\nreceived %v\n", test.name, test.exp, rec)
+		} else {
+			checkValid(t, rec)
+		}
+
+		if !slices.Equal(a, sl.Slice()) || !slices.Equal(b, other.Slice()) {
+			t.Fatalf("\n%s\nexpected %v and %v to be unchanged\n", test.name, sl, other)
+		}
+
+		if rec := test.with(sl, other); rec != sl || !slices.Equal(test.exp, sl.Slice()) {
+			t.Fatalf("\n%sWith\nexpected %v\nreceived %v\n", test.name, test.exp, sl)
+		}
+
+		checkValid(t, sl)
+		if rec := test.with(sl, sl).Slice(); !slices.Equal(test.self, rec) {
+			t.Fatalf("\n%sWith itself\nexpected %v\nreceived %v\n", test.name, test.self, rec)
+		}
+	}
+
+	if New(2, 3).IsSubset(New(a...)) != true || New(2, 2, 2).IsSubset(New(a...)) != false {
+		t.Fatalf("\nunexpected IsSubset\n")
+	}
+
+	if New(4, 6).IsDisjoint(New(a...)) != true || New(4, 5).IsDisjoint(New(a...)) != false {
+		t.Fatalf("\nunexpected IsDisjoint\n")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	f := func(a, b *testStruct) int { return a.key - b.key }
+	sl := NewFunc(f, &testStruct{1, "a"}, &testStruct{1, "c"}, &testStruct{3, "a"})
+	other := NewFunc(f, &testStruct{1, "b"}, &testStruct{2, "b"}, &testStruct{4, "b"})
+	if rec := sl.Merge(other).String(); rec != "[1, a],[1, c],[1, b],[2, b],[3, a],[4, b]" || other.Length() != 0 || other.head != nil {
+		t.Fatalf("\nreceived %s and %v\n", rec, other)
+	}
+
+	checkValid(t, sl)
+
+	var (
+		lists = make([]*SortedList[int], 8)
+		exp   []int
+	)
+
+	for k := range lists {
+		lists[k] = New[int]()
+		for j := rand.Intn(64); 0 < j; j-- {
+			x := rand.Intn(128)
+			lists[k].Insert(x)
+			exp = append(exp, x)
+		}
+	}
+
+	slices.Sort(exp)
+	dst := New(-1).MergeK(append(lists, nil)...).Merge(nil)
+	if exp = append([]int{-1}, exp...); !slices.Equal(exp, dst.Slice()) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, dst)
+	}
+
+	checkValid(t, dst)
+	for k := range lists {
+		if lists[k].Length() != 0 {
+			t.Fatalf("\nexpected empty list\nreceived %v\n", lists[k])
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	f := func(a, b *testStruct) int { return a.key - b.key }
+	sl := NewUniqueFunc(f, RejectDuplicates, &testStruct{1, "a"}, &testStruct{2, "a"}, &testStruct{1, "b"})
+	if exp, rec := "[1, a],[2, a]", sl.String(); exp != rec {
+		t.Fatalf("\nexpected %s\nreceived %s\n", exp, rec)
+	}
+
+	if sl.InsertUnique(&testStruct{2, "c"}) || !sl.InsertUnique(&testStruct{3, "c"}) {
+		t.Fatalf("\nunexpected InsertUnique in %v\n", sl)
+	}
+
+	if !sl.Upsert(&testStruct{2, "d"}) || sl.Upsert(&testStruct{4, "d"}) {
+		t.Fatalf("\nunexpected Upsert in %v\n", sl)
+	}
+
+	if exp, rec := "[1, a],[2, d],[3, c],[4, d]", sl.String(); exp != rec {
+		t.Fatalf("\nexpected %s\nreceived %s\n", exp, rec)
+	}
+
+	sl = NewUniqueFunc(f, ReplaceDuplicates, &testStruct{1, "a"}, &testStruct{2, "a"}, &testStruct{1, "b"})
+	sl.Merge(NewFunc(f, &testStruct{2, "b"}, &testStruct{2, "c"}, &testStruct{3, "b"}))
+	if exp, rec := "[1, b],[2, c],[3, b]", sl.String(); exp != rec {
+		t.Fatalf("\nexpected %s\nreceived %s\n", exp, rec)
+	}
+
+	checkValid(t, sl)
+	if exp, rec := []int{1, 2, 3}, NewUnique(RejectDuplicates, 3, 1, 2, 1).Union(New(2, 2, 3)).Slice(); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+}
+
+func TestMultiset(t *testing.T) {
+	sl := New(1, 2, 2, 3, 3, 3)
+	for i := 0; i <= 4; i++ {
+		if exp, rec := i%4, sl.Count(i); exp != rec {
+			t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+		}
+	}
+
+	var values, counts []int
+	for v, n := range sl.Distinct() {
+		values, counts = append(values, v), append(counts, n)
+	}
+
+	if !slices.Equal([]int{1, 2, 3}, values) || !slices.Equal([]int{1, 2, 3}, counts) {
+		t.Fatalf("\nexpected [1 2 3] with counts [1 2 3]\nreceived %v with counts %v\n", values, counts)
+	}
+
+	if !sl.RemoveOne(2) || sl.RemoveOne(4) {
+		t.Fatalf("\nunexpected RemoveOne in %v\n", sl)
+	}
+
+	if exp, rec := 2, sl.RemoveN(3, 2); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	if exp, rec := 1, sl.RemoveN(3, 5); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	if exp, rec := []int{1, 2}, sl.Slice(); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	checkValid(t, sl)
+}
+
+func TestReverse(t *testing.T) {
+	sl := NewDesc(3, 1, 4, 1, 5, 9, 2, 6)
+	if exp, rec := []int{9, 6, 5}, slices.Collect(sl.First(3)); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	if exp, rec := []int{1, 1, 2}, slices.Collect(sl.Last(3)); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	for _, seq := range []iter.Seq[int]{sl.First(2), sl.Last(2)} {
+		if a, b := slices.Collect(seq), slices.Collect(seq); len(a) != 2 || !slices.Equal(a, b) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", a, b)
+		}
+	}
+
+	if exp, rec := 2, len(slices.Collect(New(1, 2).First(5))); exp != rec {
+		t.Fatalf("\nexpected %d\nreceived %d\n", exp, rec)
+	}
+
+	cs := NewComparable(Reversed{testInt(1)}, Reversed{testInt(3)}, Reversed{testInt(2)})
+	if exp, rec := "3,2,1", cs.String(); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+}
+
+func TestBounded(t *testing.T) {
+	var (
+		sl      = NewBounded[int](3, EvictMin)
+		evicted []int
+	)
+
+	for _, x := range []int{5, 1, 4, 2, 8, 3, 7} {
+		if v, ok := sl.InsertEvict(x); ok {
+			evicted = append(evicted, v)
+		}
+	}
+
+	if exp, rec := []int{5, 7, 8}, sl.Slice(); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	if exp := []int{1, 2, 3, 4}; !slices.Equal(exp, evicted) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, evicted)
+	}
+
+	sl = NewBounded(3, EvictMax, 5, 1, 4, 2, 8)
+	if exp, rec := []int{1, 2, 4}, sl.Slice(); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	if exp, rec := []int{0, 1}, sl.Merge(New(0, 1, 9)).SetBound(2, EvictMax).Slice(); !slices.Equal(exp, rec) || sl.Capacity() != 2 {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	checkValid(t, sl)
+	if sl = NewBounded(2, EvictMin, 5, 6); sl.InsertUnique(1) || sl.Upsert(1) || !sl.InsertUnique(7) || !sl.Upsert(7) {
+		t.Fatalf("\nunexpected InsertUnique or Upsert in %v\n", sl)
+	}
+
+	if exp, rec := []int{6, 7}, sl.Slice(); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	if exp, rec := []int{3, 4}, NewUnique(RejectDuplicates, 1, 2, 3, 4).SetBound(2, EvictMin).Union(New(4, 4)).Slice(); !slices.Equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	sl := New(1, 2, 3, 4)
+	sl.Remove(1)
+	sl.RemoveAt(sl.Length() - 1)
+	checkValid(t, sl)
+
+	sl = New[int]()
+	for i := 0; i < 64; i++ {
+		sl.Insert(i)
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(sl *SortedList[int])
+		err     error
+	}{
+		{name: "stale head prev", corrupt: func(sl *SortedList[int]) { sl.head.prev = sl.tail }, err: ErrCorrupted},
+		{name: "asymmetric prev", corrupt: func(sl *SortedList[int]) { sl.head.next.next.prev = sl.head }, err: ErrCorrupted},
+		{name: "length", corrupt: func(sl *SortedList[int]) { sl.length-- }, err: ErrCorrupted},
+		{name: "cycle", corrupt: func(sl *SortedList[int]) { sl.head.next.next = sl.head.next }, err: ErrCorrupted},
+		{name: "tail", corrupt: func(sl *SortedList[int]) { sl.tail = sl.tail.prev; sl.tail.next = nil; sl.length-- }, err: ErrCorrupted},
+		{name: "order", corrupt: func(sl *SortedList[int]) { sl.head.value = 100 }, err: ErrUnsorted},
+		{name: "width", corrupt: func(sl *SortedList[int]) { sl.skips[0].width++ }, err: ErrCorrupted},
+	}
+
+	for _, test := range tests {
+		cpy := NewFunc(sl.compare, sl.Slice()...)
+		for len(cpy.skips) == 0 {
+			cpy = NewFunc(sl.compare, sl.Slice()...)
+		}
+
+		test.corrupt(cpy)
+		if err := cpy.Validate(); !errors.Is(err, test.err) {
+			t.Fatalf("\n%s\nexpected %v\nreceived %v\n", test.name, test.err, err)
+		}
+	}
+}
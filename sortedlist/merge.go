@@ -0,0 +1,128 @@
+package sortedlist
+
+import "container/heap"
+
+// run is the next item of one of several sorted lists being merged and the
+// position of its list among them.
+type run[T any] struct {
+	itm *item[T]
+	k   int
+}
+
+// runHeap is a min-heap of runs. Runs with equal values are ordered by the
+// position of their list, so merging is stable.
+type runHeap[T any] struct {
+	runs    []run[T]
+	compare Comparer[T]
+}
+
+// Len returns the number of runs.
+func (h *runHeap[T]) Len() int {
+	return len(h.runs)
+}
+
+// Less compares the ith and jth runs.
+func (h *runHeap[T]) Less(i, j int) bool {
+	r := h.compare(h.runs[i].itm.value, h.runs[j].itm.value)
+	return r < 0 || r == 0 && h.runs[i].k < h.runs[j].k
+}
+
+// Pop removes the last run.
+func (h *runHeap[T]) Pop() interface{} {
+	x := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+	return x
+}
+
+// Push appends a run.
+func (h *runHeap[T]) Push(x interface{}) {
+	h.runs = append(h.runs, x.(run[T]))
+}
+
+// Swap the ith and jth runs.
+func (h *runHeap[T]) Swap(i, j int) {
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+// Merge moves the values of another sorted list into a sorted list in O(n+m)
+// expected time. Items are relinked rather than copied, leaving the other list
+// empty. Equal values from the sorted list precede those from the other, or
+// are rejected or replaced given the sorted list's duplicate policy. Values
+// beyond the sorted list's capacity are evicted. The other list must be ordered
+// by the sorted list's comparer; merging a list in another order, such as one
+// created by NewDesc, leaves the sorted list unsorted. A nil list is ignored.
+func (sl *SortedList[T]) Merge(other *SortedList[T]) *SortedList[T] {
+	switch other {
+	case sl:
+		panic("sortedlist: cannot merge a list with itself")
+	case nil:
+		return sl
+	}
+
+	compare := sl.comparer()
+	a, b := sl.head, other.head
+	sl.reset()
+	other.reset()
+	p := sl.end()
+	for a != nil || b != nil {
+		var itm *item[T]
+		if b == nil || a != nil && compare(a.value, b.value) <= 0 {
+			itm, a = a, a.next
+		} else {
+			itm, b = b, b.next
+		}
+
+		sl.pushBack(&p, itm)
+	}
+
+	sl.seal(&p)
+	sl.trim()
+	return sl
+}
+
+// MergeK moves the values of several sorted lists into a sorted list in
+// O(n log k) expected time for n values in k lists. Items are relinked rather
+// than copied, leaving the other lists empty. Equal values are kept in the
+// order of their lists, starting with the sorted list, or are rejected or
+// replaced given the sorted list's duplicate policy. Values beyond the sorted
+// list's capacity are evicted. The other lists must be ordered by the sorted
+// list's comparer, as in Merge. Nil lists are ignored.
+func (sl *SortedList[T]) MergeK(lists ...*SortedList[T]) *SortedList[T] {
+	var (
+		h    = runHeap[T]{runs: make([]run[T], 0, len(lists)+1), compare: sl.comparer()}
+		seen = make(map[*SortedList[T]]bool, len(lists)+1)
+	)
+
+	for k, ls := range append([]*SortedList[T]{sl}, lists...) {
+		switch {
+		case ls == nil:
+			continue
+		case seen[ls]:
+			panic("sortedlist: cannot merge a list with itself")
+		}
+
+		seen[ls] = true
+		if ls.head != nil {
+			h.runs = append(h.runs, run[T]{itm: ls.head, k: k})
+		}
+
+		ls.reset()
+	}
+
+	heap.Init(&h)
+	p := sl.end()
+	for 0 < h.Len() {
+		itm := h.runs[0].itm
+		if h.runs[0].itm = itm.next; itm.next == nil {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+
+		sl.pushBack(&p, itm)
+	}
+
+	sl.seal(&p)
+	sl.trim()
+	return sl
+}
@@ -0,0 +1,52 @@
+package sortedlist
+
+import "iter"
+
+// Count returns the number of values equal to a value in O(log n) expected
+// time.
+func (sl *SortedList[T]) Count(value T) int {
+	return sl.searchUpper(value).index[0] - sl.searchLower(value).index[0]
+}
+
+// Distinct returns an iterator over each distinct value in ascending order and
+// the number of values equal to it. The first of several equal values is
+// yielded.
+func (sl *SortedList[T]) Distinct() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for itm := sl.head; itm != nil; {
+			n, next := 1, itm.next
+			for ; next != nil && sl.compare(itm.value, next.value) == 0; next = next.next {
+				n++
+			}
+
+			if !yield(itm.value, n) {
+				return
+			}
+
+			itm = next
+		}
+	}
+}
+
+// RemoveN removes up to n values equal to a value in O(log n + n) expected
+// time. The first equal values are removed. Returns the number of values
+// removed.
+func (sl *SortedList[T]) RemoveN(value T, n int) int {
+	p := sl.searchLower(value)
+	var removed int
+	for ; removed < n; removed++ {
+		if itm, _ := sl.forward(p.items[0], 0); itm == nil || sl.compare(itm.value, value) != 0 {
+			break
+		}
+
+		sl.unlink(&p)
+	}
+
+	return removed
+}
+
+// RemoveOne removes the first value equal to a value in O(log n) expected
+// time. Returns true if a value was removed.
+func (sl *SortedList[T]) RemoveOne(value T) bool {
+	return sl.RemoveN(value, 1) == 1
+}
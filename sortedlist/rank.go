@@ -0,0 +1,57 @@
+package sortedlist
+
+import "math"
+
+// At returns the ith smallest value in O(log n) expected time.
+func (sl *SortedList[T]) At(i int) T {
+	if i < 0 || sl.length <= i {
+		panic("index out of range")
+	}
+
+	p := sl.searchIndex(i)
+	itm, _ := sl.forward(p.items[0], 0)
+	return itm.value
+}
+
+// Median returns the middle value of a sorted list. If the length is even, the
+// lower of the two middle values is returned. Panics if the list is empty.
+func (sl *SortedList[T]) Median() T {
+	return sl.At((sl.length - 1) / 2)
+}
+
+// Percentile returns the value at the pth percentile, for p on the range
+// [0,100], using the nearest-rank method. Panics if the list is empty.
+func (sl *SortedList[T]) Percentile(p float64) T {
+	if p < 0 || 100 < p || math.IsNaN(p) {
+		panic("percentile out of range")
+	}
+
+	return sl.At(max(int(math.Ceil(p/100*float64(sl.length)))-1, 0))
+}
+
+// Quantiles returns the k-1 values dividing a sorted list into k groups of
+// nearly equal size using the nearest-rank method. Panics if k < 1 or the list
+// is empty.
+func (sl *SortedList[T]) Quantiles(k int) []T {
+	if k < 1 {
+		panic("quantile count out of range")
+	}
+
+	if sl.length == 0 {
+		panic("index out of range")
+	}
+
+	q := make([]T, 0, k-1)
+	for j := 1; j < k; j++ {
+		q = append(q, sl.At(max((j*sl.length+k-1)/k-1, 0)))
+	}
+
+	return q
+}
+
+// Rank returns the number of values less than a value in O(log n) expected
+// time.
+func (sl *SortedList[T]) Rank(value T) int {
+	p := sl.searchLower(value)
+	return p.index[0] + 1
+}
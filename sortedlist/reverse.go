@@ -0,0 +1,57 @@
+package sortedlist
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// Reversed is a comparable value ordered in reverse. It may only be compared
+// to other reversed values.
+type Reversed struct {
+	Comparable
+}
+
+// NewDesc creates a new sorted list of ordered values in descending order.
+func NewDesc[T cmp.Ordered](values ...T) *SortedList[T] {
+	return NewFunc(Reverse(cmp.Compare[T]), values...)
+}
+
+// Reverse returns a comparer ordering values in the reverse order of another.
+func Reverse[T any](f Comparer[T]) Comparer[T] {
+	return func(a, b T) int { return f(b, a) }
+}
+
+// Compare two reversed values.
+func (r Reversed) Compare(c Comparable) int {
+	return c.(Reversed).Comparable.Compare(r.Comparable)
+}
+
+// String represents a reversed value as its underlying value.
+func (r Reversed) String() string {
+	return fmt.Sprintf("%v", r.Comparable)
+}
+
+// First returns an iterator over up to the first n values of a sorted list,
+// from the head.
+func (sl *SortedList[T]) First(n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k, itm := n, sl.head; 0 < k && itm != nil; k, itm = k-1, itm.next {
+			if !yield(itm.value) {
+				return
+			}
+		}
+	}
+}
+
+// Last returns an iterator over up to the last n values of a sorted list,
+// from the tail.
+func (sl *SortedList[T]) Last(n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k, itm := n, sl.tail; 0 < k && itm != nil; k, itm = k-1, itm.prev {
+			if !yield(itm.value) {
+				return
+			}
+		}
+	}
+}
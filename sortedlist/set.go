@@ -0,0 +1,205 @@
+package sortedlist
+
+// setOp determines which values a set operation keeps: those only in the
+// receiver, those only in the other list, and those in both.
+type setOp int
+
+const (
+	keepLeft setOp = 1 << iota
+	keepRight
+	keepBoth
+
+	union        = keepLeft | keepRight | keepBoth
+	intersection = keepBoth
+	difference   = keepLeft
+	symmetric    = keepLeft | keepRight
+)
+
+// combine returns a new sorted list of the values kept by a set operation.
+func (sl *SortedList[T]) combine(other *SortedList[T], op setOp) *SortedList[T] {
+	var (
+		dst = sl.like()
+		p   = dst.end()
+	)
+
+	walk(sl.comparer(), sl.head, other.head, func(a, b *item[T]) bool {
+		if itm := keep(op, a, b); itm != nil {
+			dst.pushBack(&p, newItem(itm.value))
+		}
+
+		return true
+	})
+
+	dst.seal(&p)
+	dst.trim()
+	return dst
+}
+
+// combineWith relinks a sorted list to hold the values kept by a set
+// operation. Items kept from the sorted list are reused.
+func (sl *SortedList[T]) combineWith(other *SortedList[T], op setOp) *SortedList[T] {
+	if other == sl {
+		// Walking a list while relinking it requires a copy
+		other = sl.combine(NewFunc(sl.compare), keepLeft)
+	}
+
+	head := sl.head
+	sl.reset()
+	p := sl.end()
+	walk(sl.comparer(), head, other.head, func(a, b *item[T]) bool {
+		switch itm := keep(op, a, b); {
+		case itm == nil:
+		case itm == a:
+			sl.pushBack(&p, a)
+		default:
+			sl.pushBack(&p, newItem(itm.value))
+		}
+
+		return true
+	})
+
+	sl.seal(&p)
+	sl.trim()
+	return sl
+}
+
+// Difference returns a new sorted list of the values in a sorted list that are
+// not in another in O(n+m) expected time. Equal values are matched one-to-one,
+// as with multisets. The other list must be ordered by the sorted list's
+// comparer.
+func (sl *SortedList[T]) Difference(other *SortedList[T]) *SortedList[T] {
+	return sl.combine(other, difference)
+}
+
+// DifferenceWith removes the values in another sorted list from a sorted list
+// in O(n+m) expected time. The other list must be ordered by the sorted list's
+// comparer.
+func (sl *SortedList[T]) DifferenceWith(other *SortedList[T]) *SortedList[T] {
+	return sl.combineWith(other, difference)
+}
+
+// Intersect returns a new sorted list of the values in both a sorted list and
+// another in O(n+m) expected time. Equal values are matched one-to-one, as with
+// multisets. The other list must be ordered by the sorted list's comparer.
+func (sl *SortedList[T]) Intersect(other *SortedList[T]) *SortedList[T] {
+	return sl.combine(other, intersection)
+}
+
+// IntersectWith removes the values not in another sorted list from a sorted
+// list in O(n+m) expected time. The other list must be ordered by the sorted
+// list's comparer.
+func (sl *SortedList[T]) IntersectWith(other *SortedList[T]) *SortedList[T] {
+	return sl.combineWith(other, intersection)
+}
+
+// IsDisjoint returns true if a sorted list and another have no values in
+// common. The other list must be ordered by the sorted list's comparer.
+func (sl *SortedList[T]) IsDisjoint(other *SortedList[T]) bool {
+	disjoint := true
+	walk(sl.comparer(), sl.head, other.head, func(a, b *item[T]) bool {
+		disjoint = a == nil || b == nil
+		return disjoint
+	})
+
+	return disjoint
+}
+
+// IsSubset returns true if every value in a sorted list is in another. Equal
+// values are matched one-to-one, as with multisets. The other list must be
+// ordered by the sorted list's comparer.
+func (sl *SortedList[T]) IsSubset(other *SortedList[T]) bool {
+	subset := true
+	walk(sl.comparer(), sl.head, other.head, func(a, b *item[T]) bool {
+		subset = a == nil || b != nil
+		return subset
+	})
+
+	return subset
+}
+
+// SymmetricDifference returns a new sorted list of the values in exactly one of
+// a sorted list and another in O(n+m) expected time. Equal values are matched
+// one-to-one, as with multisets. The other list must be ordered by the sorted
+// list's comparer.
+func (sl *SortedList[T]) SymmetricDifference(other *SortedList[T]) *SortedList[T] {
+	return sl.combine(other, symmetric)
+}
+
+// SymmetricDifferenceWith replaces a sorted list with the values in exactly one
+// of it and another sorted list in O(n+m) expected time. The other list must be
+// ordered by the sorted list's comparer.
+func (sl *SortedList[T]) SymmetricDifferenceWith(other *SortedList[T]) *SortedList[T] {
+	return sl.combineWith(other, symmetric)
+}
+
+// Union returns a new sorted list of the values in either a sorted list or
+// another in O(n+m) expected time. Equal values are matched one-to-one, as with
+// multisets. The other list must be ordered by the sorted list's comparer.
+func (sl *SortedList[T]) Union(other *SortedList[T]) *SortedList[T] {
+	return sl.combine(other, union)
+}
+
+// UnionWith adds the values in another sorted list not already in a sorted list
+// in O(n+m) expected time. The other list must be ordered by the sorted list's
+// comparer.
+func (sl *SortedList[T]) UnionWith(other *SortedList[T]) *SortedList[T] {
+	return sl.combineWith(other, union)
+}
+
+// keep returns the item a set operation keeps given an item only in the left
+// list, only in the right list, or a pair of equal items in both. Returns nil
+// if neither is kept.
+func keep[T any](op setOp, a, b *item[T]) *item[T] {
+	switch {
+	case b == nil && op&keepLeft != 0:
+		return a
+	case a == nil && op&keepRight != 0:
+		return b
+	case a != nil && b != nil && op&keepBoth != 0:
+		return a
+	default:
+		return nil
+	}
+}
+
+// walk two sorted runs of items in order, matching equal items one-to-one.
+// Yield is called with an item only in a, an item only in b, or a pair of
+// equal items, until it returns false. Each item's next reference is read
+// before it is yielded, so yield may relink it.
+func walk[T any](compare Comparer[T], a, b *item[T], yield func(a, b *item[T]) bool) {
+	for a != nil || b != nil {
+		r := 0
+		switch {
+		case a == nil:
+			r = 1
+		case b == nil:
+			r = -1
+		default:
+			r = compare(a.value, b.value)
+		}
+
+		switch {
+		case r < 0:
+			next := a.next
+			if !yield(a, nil) {
+				return
+			}
+
+			a = next
+		case 0 < r:
+			next := b.next
+			if !yield(nil, b) {
+				return
+			}
+
+			b = next
+		default:
+			nextA, nextB := a.next, b.next
+			if !yield(a, b) {
+				return
+			}
+
+			a, b = nextA, nextB
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package sortedlist
+
+import "math/rand/v2"
+
+// maxLevel is the maximum number of levels in a sorted list, including the
+// base level of prev and next references.
+const maxLevel = 32
+
+// skip references the next item on an express level and the number of items
+// skipped to reach it. A nil next item skips to the end of the list.
+type skip[T any] struct {
+	next  *item[T]
+	width int
+}
+
+// path holds the last item before a position on each level and its index. A
+// nil item is the front of the list, having index -1.
+type path[T any] struct {
+	items [maxLevel]*item[T]
+	index [maxLevel]int
+}
+
+// appendItem links an item after a path to the tail. Only the item's own
+// levels are updated, so appending n items takes O(n) expected time, but the
+// widths of the last links on each level are left stale until the path is
+// sealed.
+func (sl *SortedList[T]) appendItem(p *path[T], itm *item[T]) {
+	h := len(itm.skips) + 1
+	for l := sl.height(); l < h; l++ {
+		sl.skips = append(sl.skips, skip[T]{})
+		p.items[l], p.index[l] = nil, -1
+	}
+
+	if itm.prev, itm.next = p.items[0], nil; itm.prev == nil {
+		sl.head = itm
+	} else {
+		itm.prev.next = itm
+	}
+
+	sl.tail = itm
+	i := p.index[0] + 1
+	for l := 1; l < h; l++ {
+		s := sl.skip(p.items[l], l)
+		s.next, s.width = itm, i-p.index[l]
+		itm.skips[l-1] = skip[T]{}
+	}
+
+	for l := 0; l < h; l++ {
+		p.items[l], p.index[l] = itm, i
+	}
+
+	sl.length++
+}
+
+// end returns the path to the position following the tail.
+func (sl *SortedList[T]) end() path[T] {
+	return sl.searchIndex(sl.length)
+}
+
+// forward returns the next item on level l following an item and the number
+// of items skipped to reach it. A nil item is the front of the list.
+func (sl *SortedList[T]) forward(itm *item[T], l int) (*item[T], int) {
+	switch {
+	case l == 0 && itm == nil:
+		return sl.head, 1
+	case l == 0:
+		return itm.next, 1
+	default:
+		s := sl.skip(itm, l)
+		return s.next, s.width
+	}
+}
+
+// height returns the number of levels in a sorted list.
+func (sl *SortedList[T]) height() int {
+	return len(sl.skips) + 1
+}
+
+// insert a value after all values comparing less than or equal to it and
+// return its item.
+func (sl *SortedList[T]) insert(value T) *item[T] {
+	var (
+		p   = sl.searchUpper(value)
+		itm = newItem(value)
+	)
+
+	sl.link(&p, itm)
+	return itm
+}
+
+// link an item into every level it belongs to at the position following a
+// path. The path is advanced past the item, so items may be appended in order
+// by linking each with the same path.
+func (sl *SortedList[T]) link(p *path[T], itm *item[T]) {
+	h := len(itm.skips) + 1
+	for l := sl.height(); l < h; l++ {
+		sl.skips = append(sl.skips, skip[T]{width: sl.length + 1})
+		p.items[l], p.index[l] = nil, -1
+	}
+
+	itm.prev = p.items[0]
+	if itm.prev == nil {
+		itm.next = sl.head
+		sl.head = itm
+	} else {
+		itm.next = itm.prev.next
+		itm.prev.next = itm
+	}
+
+	if itm.next == nil {
+		sl.tail = itm
+	} else {
+		itm.next.prev = itm
+	}
+
+	i := p.index[0] + 1
+	for l := 1; l < sl.height(); l++ {
+		s := sl.skip(p.items[l], l)
+		if l < h {
+			itm.skips[l-1] = skip[T]{next: s.next, width: s.width - (i - 1 - p.index[l])}
+			s.next, s.width = itm, i-p.index[l]
+		} else {
+			s.width++
+		}
+	}
+
+	for l := 0; l < h; l++ {
+		p.items[l], p.index[l] = itm, i
+	}
+
+	sl.length++
+}
+
+// reset a sorted list to be empty without modifying its items.
+func (sl *SortedList[T]) reset() {
+	sl.head, sl.tail, sl.skips, sl.length = nil, nil, nil, 0
+}
+
+// seal sets the widths of the last links on each level following a path to
+// the tail, after appending items.
+func (sl *SortedList[T]) seal(p *path[T]) {
+	for l := 1; l < sl.height(); l++ {
+		sl.skip(p.items[l], l).width = sl.length - p.index[l]
+	}
+}
+
+// search returns the path to the first item for which before returns false,
+// given each item and its index.
+func (sl *SortedList[T]) search(before func(itm *item[T], i int) bool) path[T] {
+	var (
+		p   path[T]
+		itm *item[T]
+		i   = -1
+	)
+
+	for l := sl.height() - 1; 0 <= l; l-- {
+		for {
+			next, w := sl.forward(itm, l)
+			if next == nil || !before(next, i+w) {
+				break
+			}
+
+			itm, i = next, i+w
+		}
+
+		p.items[l], p.index[l] = itm, i
+	}
+
+	return p
+}
+
+// searchIndex returns the path to the ith item.
+func (sl *SortedList[T]) searchIndex(i int) path[T] {
+	return sl.search(func(_ *item[T], j int) bool { return j < i })
+}
+
+// searchLower returns the path to the first item not less than a value.
+func (sl *SortedList[T]) searchLower(value T) path[T] {
+	compare := sl.comparer()
+	return sl.search(func(itm *item[T], _ int) bool { return compare(itm.value, value) < 0 })
+}
+
+// searchUpper returns the path to the first item greater than a value.
+func (sl *SortedList[T]) searchUpper(value T) path[T] {
+	compare := sl.comparer()
+	return sl.search(func(itm *item[T], _ int) bool { return compare(itm.value, value) <= 0 })
+}
+
+// skip returns the express reference on level l, for l > 0, following an item.
+// A nil item is the front of the list.
+func (sl *SortedList[T]) skip(itm *item[T], l int) *skip[T] {
+	if itm == nil {
+		return &sl.skips[l-1]
+	}
+
+	return &itm.skips[l-1]
+}
+
+// unlink the item following a path from every level.
+func (sl *SortedList[T]) unlink(p *path[T]) *item[T] {
+	itm, _ := sl.forward(p.items[0], 0)
+	for l := 1; l < sl.height(); l++ {
+		s := sl.skip(p.items[l], l)
+		if s.next == itm {
+			s.next, s.width = itm.skips[l-1].next, s.width+itm.skips[l-1].width-1
+		} else {
+			s.width--
+		}
+	}
+
+	if itm.prev == nil {
+		sl.head = itm.next
+	} else {
+		itm.prev.next = itm.next
+	}
+
+	if itm.next == nil {
+		sl.tail = itm.prev
+	} else {
+		itm.next.prev = itm.prev
+	}
+
+	for 0 < len(sl.skips) && sl.skips[len(sl.skips)-1].next == nil {
+		sl.skips = sl.skips[:len(sl.skips)-1]
+	}
+
+	itm.prev, itm.next, itm.skips = nil, nil, nil
+	sl.length--
+	return itm
+}
+
+// newItem returns an item of random height holding a value.
+func newItem[T any](value T) *item[T] {
+	itm := &item[T]{value: value}
+	if h := randomHeight(); 1 < h {
+		itm.skips = make([]skip[T], h-1)
+	}
+
+	return itm
+}
+
+// randomHeight returns the number of levels for a new item. Each additional
+// level occurs with probability 1/4.
+func randomHeight() int {
+	h := 1
+	for h < maxLevel && rand.Uint32()&3 == 0 {
+		h++
+	}
+
+	return h
+}
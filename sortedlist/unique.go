@@ -0,0 +1,84 @@
+package sortedlist
+
+// DuplicatePolicy determines how a sorted list inserts a value comparing equal
+// to one it already holds.
+type DuplicatePolicy int
+
+const (
+	// AllowDuplicates inserts equal values after those already held.
+	AllowDuplicates DuplicatePolicy = iota
+
+	// RejectDuplicates keeps the value already held.
+	RejectDuplicates
+
+	// ReplaceDuplicates replaces the value already held.
+	ReplaceDuplicates
+)
+
+// InsertUnique inserts a value if no equal value is held in O(log n) expected
+// time. Returns true if the value was inserted. A value a full list would drop
+// given its eviction is not inserted.
+func (sl *SortedList[T]) InsertUnique(value T) bool {
+	if sl.rejects(value) {
+		return false
+	}
+
+	ok := sl.insertUnique(value)
+	sl.trim()
+	return ok
+}
+
+// insertUnique inserts a value if no equal value is held, ignoring the
+// capacity.
+func (sl *SortedList[T]) insertUnique(value T) bool {
+	p := sl.searchUpper(value)
+	if itm := p.items[0]; itm != nil && sl.compare(itm.value, value) == 0 {
+		return false
+	}
+
+	sl.link(&p, newItem(value))
+	return true
+}
+
+// pushBack appends an item after a path to the tail, applying the duplicate
+// policy if it compares equal to the tail. The item must not compare less than
+// the tail. Only the item's own levels are updated, so the path must be sealed
+// after the last item is appended.
+func (sl *SortedList[T]) pushBack(p *path[T], itm *item[T]) {
+	if tail := p.items[0]; sl.policy != AllowDuplicates && tail != nil && sl.compare(tail.value, itm.value) == 0 {
+		if sl.policy == ReplaceDuplicates {
+			tail.value = itm.value
+		}
+
+		return
+	}
+
+	sl.appendItem(p, itm)
+}
+
+// Upsert replaces the last value equal to a value, or inserts the value if no
+// equal value is held, in O(log n) expected time. Returns true if a value was
+// replaced. A value a full list would drop given its eviction is neither
+// inserted nor replaces another.
+func (sl *SortedList[T]) Upsert(value T) bool {
+	if sl.rejects(value) {
+		return false
+	}
+
+	ok := sl.upsert(value)
+	sl.trim()
+	return ok
+}
+
+// upsert replaces the last value equal to a value, or inserts the value if no
+// equal value is held, ignoring the capacity.
+func (sl *SortedList[T]) upsert(value T) bool {
+	p := sl.searchUpper(value)
+	if itm := p.items[0]; itm != nil && sl.compare(itm.value, value) == 0 {
+		itm.value = value
+		return true
+	}
+
+	sl.link(&p, newItem(value))
+	return false
+}
@@ -0,0 +1,82 @@
+package sortedlist
+
+import "fmt"
+
+// Validate returns an error wrapping ErrCorrupted if a sorted list's head and
+// tail are inconsistent, its previous and next references are not symmetric,
+// it contains a cycle, its length does not match its number of items, or its
+// express levels skip to the wrong items. Returns an error wrapping
+// ErrUnsorted if a value compares greater than the value following it.
+func (sl *SortedList[T]) Validate() error {
+	switch {
+	case (sl.head == nil) != (sl.tail == nil):
+		return fmt.Errorf("%w: head is nil is %t, but tail is nil is %t", ErrCorrupted, sl.head == nil, sl.tail == nil)
+	case sl.head != nil && sl.head.prev != nil:
+		return fmt.Errorf("%w: head references a previous item", ErrCorrupted)
+	case sl.tail != nil && sl.tail.next != nil:
+		return fmt.Errorf("%w: tail references a next item", ErrCorrupted)
+	}
+
+	var (
+		n    int
+		prev *item[T]
+	)
+
+	for itm := sl.head; itm != nil; prev, itm = itm, itm.next {
+		if n == sl.length {
+			return fmt.Errorf("%w: more than %d items or a cycle", ErrCorrupted, sl.length)
+		}
+
+		if itm.prev != prev {
+			return fmt.Errorf("%w: item %d does not reference item %d as previous", ErrCorrupted, n, n-1)
+		}
+
+		if prev != nil && 0 < sl.compare(prev.value, itm.value) {
+			return fmt.Errorf("%w: item %d (%v) is greater than item %d (%v)", ErrUnsorted, n-1, prev.value, n, itm.value)
+		}
+
+		n++
+	}
+
+	switch {
+	case n != sl.length:
+		return fmt.Errorf("%w: length is %d, but found %d items", ErrCorrupted, sl.length, n)
+	case prev != sl.tail:
+		return fmt.Errorf("%w: item %d is not the tail", ErrCorrupted, n-1)
+	}
+
+	for l := 1; l < sl.height(); l++ {
+		var (
+			itm, at *item[T]
+			i       = -1
+		)
+
+		for {
+			next, w := sl.forward(itm, l)
+			if w < 1 || sl.length < i+w {
+				return fmt.Errorf("%w: level %d skips %d items from item %d", ErrCorrupted, l, w, i)
+			}
+
+			for ; 0 < w; w-- {
+				at, _ = sl.forward(at, 0)
+				i++
+			}
+
+			if next != at {
+				return fmt.Errorf("%w: level %d does not skip to item %d", ErrCorrupted, l, i)
+			}
+
+			if next == nil {
+				break
+			}
+
+			if len(next.skips) < l {
+				return fmt.Errorf("%w: item %d is not on level %d", ErrCorrupted, i, l)
+			}
+
+			itm = next
+		}
+	}
+
+	return nil
+}
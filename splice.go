@@ -0,0 +1,120 @@
+package list
+
+// Concat moves the values of another list onto the end of a list in O(1)
+// time. Items are relinked rather than copied, leaving the other list empty.
+func (ls *List[T]) Concat(other *List[T]) *List[T] {
+	return ls.splice(ls.tail, nil, other)
+}
+
+// Extract removes the values on the range [i,j) from a list and returns them
+// as a new list. Items are relinked rather than copied.
+func (ls *List[T]) Extract(i, j int) *List[T] {
+	if j < i || i < 0 || ls.length < j {
+		panic("index out of range")
+	}
+
+	ext := New(ls.less)
+	if i == j {
+		return ext
+	}
+
+	ext.head, ext.tail, ext.length = ls.item(i), ls.item(j-1), j-i
+	if ext.head.prev == nil {
+		ls.head = ext.tail.next
+	} else {
+		ext.head.prev.next = ext.tail.next
+	}
+
+	if ext.tail.next == nil {
+		ls.tail = ext.head.prev
+	} else {
+		ext.tail.next.prev = ext.head.prev
+	}
+
+	ext.head.prev, ext.tail.next = nil, nil
+	ls.length -= ext.length
+	return ext
+}
+
+// Reverse the order of a list in place in O(n) time.
+func (ls *List[T]) Reverse() *List[T] {
+	for itm := ls.head; itm != nil; itm = itm.prev {
+		itm.prev, itm.next = itm.next, itm.prev
+	}
+
+	ls.head, ls.tail = ls.tail, ls.head
+	return ls
+}
+
+// Rotate a list in place so the kth value becomes the head in O(min(k, n-k))
+// time. The index k is taken modulo the length, so a negative k rotates
+// toward the tail.
+func (ls *List[T]) Rotate(k int) *List[T] {
+	if ls.length == 0 {
+		return ls
+	}
+
+	if k %= ls.length; k < 0 {
+		k += ls.length
+	}
+
+	if k == 0 {
+		return ls
+	}
+
+	head := ls.item(k)
+	ls.tail.next, ls.head.prev = ls.head, ls.tail
+	ls.head, ls.tail = head, head.prev
+	ls.head.prev, ls.tail.next = nil, nil
+	return ls
+}
+
+// splice the items of another list between two adjacent items. A nil prev or
+// next splices the items as the new head or tail, respectively.
+func (ls *List[T]) splice(prev, next *Element[T], other *List[T]) *List[T] {
+	if other == ls {
+		panic("list: cannot splice a list into itself")
+	}
+
+	if other.length == 0 {
+		return ls
+	}
+
+	other.head.prev, other.tail.next = prev, next
+	if prev == nil {
+		ls.head = other.head
+	} else {
+		prev.next = other.head
+	}
+
+	if next == nil {
+		ls.tail = other.tail
+	} else {
+		next.prev = other.tail
+	}
+
+	ls.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
+	return ls
+}
+
+// SpliceAt moves the values of another list into a list at the ith index.
+// Items are relinked rather than copied, leaving the other list empty.
+func (ls *List[T]) SpliceAt(i int, other *List[T]) *List[T] {
+	switch {
+	case i < 0, ls.length < i:
+		panic("index out of range")
+	case i == ls.length:
+		return ls.splice(ls.tail, nil, other)
+	default:
+		next := ls.item(i)
+		return ls.splice(next.prev, next, other)
+	}
+}
+
+// SplitAt splits a list at the ith index in O(min(i, n-i)) time. The list
+// keeps the values on the range [0,i) and the values on the range [i,n) are
+// returned as a new list. Items are relinked rather than copied.
+func (ls *List[T]) SplitAt(i int) (*List[T], *List[T]) {
+	return ls, ls.Extract(i, ls.length)
+}
@@ -0,0 +1,43 @@
+package list
+
+import "fmt"
+
+// Validate returns an error wrapping ErrCorrupted if a list's head and tail
+// are inconsistent, its previous and next references are not symmetric, it
+// contains a cycle, or its length does not match its number of items.
+func (ls *List[T]) Validate() error {
+	switch {
+	case (ls.head == nil) != (ls.tail == nil):
+		return fmt.Errorf("%w: head is nil is %t, but tail is nil is %t", ErrCorrupted, ls.head == nil, ls.tail == nil)
+	case ls.head != nil && ls.head.prev != nil:
+		return fmt.Errorf("%w: head references a previous item", ErrCorrupted)
+	case ls.tail != nil && ls.tail.next != nil:
+		return fmt.Errorf("%w: tail references a next item", ErrCorrupted)
+	}
+
+	var (
+		n    int
+		prev *Element[T]
+	)
+
+	for itm := ls.head; itm != nil; prev, itm = itm, itm.next {
+		if n == ls.length {
+			return fmt.Errorf("%w: more than %d items or a cycle", ErrCorrupted, ls.length)
+		}
+
+		if itm.prev != prev {
+			return fmt.Errorf("%w: item %d does not reference item %d as previous", ErrCorrupted, n, n-1)
+		}
+
+		n++
+	}
+
+	switch {
+	case n != ls.length:
+		return fmt.Errorf("%w: length is %d, but found %d items", ErrCorrupted, ls.length, n)
+	case prev != ls.tail:
+		return fmt.Errorf("%w: item %d is not the tail", ErrCorrupted, n-1)
+	default:
+		return nil
+	}
+}